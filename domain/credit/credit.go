@@ -0,0 +1,151 @@
+// Package credit decides whether a user may take on a new BNPL purchase from
+// their credit limit and the payments they still owe.
+package credit
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+)
+
+var (
+	ErrInvalidAmount = errors.New("invalid purchase amount")
+	ErrInvalidPolicy = errors.New("invalid credit policy")
+)
+
+const defaultBlockAfterDaysOverdue = 30
+
+// Policy holds the thresholds used to build a Profile.
+type Policy struct {
+	// BlockAfterDaysOverdue blocks new purchases once any unpaid payment is
+	// this many days past due. Zero means 30 days.
+	BlockAfterDaysOverdue int
+}
+
+// DefaultPolicy blocks after 30 days overdue.
+var DefaultPolicy = Policy{BlockAfterDaysOverdue: defaultBlockAfterDaysOverdue}
+
+// Reason explains why a purchase was declined.
+type Reason string
+
+const (
+	ReasonNoCreditLimit    Reason = "NO_CREDIT_LIMIT"
+	ReasonCurrencyMismatch Reason = "CURRENCY_MISMATCH"
+	ReasonLimitExceeded    Reason = "LIMIT_EXCEEDED"
+	ReasonOverdueBlocked   Reason = "OVERDUE_BLOCKED"
+	ReasonUserNotActive    Reason = "USER_NOT_ACTIVE"
+	// ReasonForeignExposure means the user owes payments in a currency other
+	// than the limit, so their exposure cannot be measured against it.
+	ReasonForeignExposure Reason = "FOREIGN_EXPOSURE"
+)
+
+// Profile is a point-in-time view of a user's credit position.
+type Profile struct {
+	UserID user.ID
+	Status user.Status
+	AsOf   time.Time
+	Limit  money.Money
+	// Exposure is principal plus accrued penalty of every unpaid payment.
+	Exposure  money.Money
+	Available money.Money
+	// ForeignPayments counts unpaid payments in another currency than the
+	// limit; they are left out of Exposure and decline every purchase.
+	ForeignPayments int
+	// OverduePayments counts unpaid payments past their due date.
+	OverduePayments int
+	MaxDaysOverdue  int
+	Blocked         bool
+}
+
+// Decision is the outcome of an eligibility check; Reasons is empty when approved.
+type Decision struct {
+	Approved bool
+	Reasons  []Reason
+	Profile  Profile
+}
+
+// NewProfile computes exposure and blocking from the user's payments as of asOf.
+// Payments in another currency than the limit are counted in ForeignPayments
+// rather than summed into Exposure.
+func NewProfile(u *user.User, payments []*payment.Payment, policy Policy, asOf time.Time) (Profile, error) {
+	if policy.BlockAfterDaysOverdue < 0 {
+		return Profile{}, ErrInvalidPolicy
+	}
+	if policy.BlockAfterDaysOverdue == 0 {
+		policy.BlockAfterDaysOverdue = defaultBlockAfterDaysOverdue
+	}
+
+	profile := Profile{UserID: u.ID(), Status: u.Status(), AsOf: asOf, Limit: u.CreditLimit()}
+	currency := u.CreditLimit().Currency()
+	if currency == "" {
+		return profile, nil
+	}
+
+	zero, err := money.Zero(currency)
+	if err != nil {
+		return Profile{}, err
+	}
+	exposure := []money.Money{zero}
+	for _, p := range payments {
+		if p.UserID() != u.ID() || p.Status() == payment.StatusPaid {
+			continue
+		}
+		if p.Amount().Currency() != currency {
+			profile.ForeignPayments++
+		} else {
+			exposure = append(exposure, p.Amount())
+			if info := p.OverdueInfo(); info != nil {
+				exposure = append(exposure, info.Penalty)
+			}
+		}
+		if days := p.DaysPastDue(asOf); days > 0 {
+			profile.OverduePayments++
+			profile.MaxDaysOverdue = max(profile.MaxDaysOverdue, days)
+		}
+	}
+
+	if profile.Exposure, err = money.Sum(exposure...); err != nil {
+		return Profile{}, err
+	}
+	if profile.Available, err = profile.Limit.Sub(profile.Exposure); err != nil {
+		return Profile{}, err
+	}
+	profile.Blocked = profile.MaxDaysOverdue >= policy.BlockAfterDaysOverdue
+	return profile, nil
+}
+
+// Evaluate decides whether amount fits the profile and collects every reason
+// it does not.
+func (p Profile) Evaluate(amount money.Money) (Decision, error) {
+	if !amount.IsPositive() {
+		return Decision{}, ErrInvalidAmount
+	}
+
+	var reasons []Reason
+	if !p.Status.CanBorrow() {
+		reasons = append(reasons, ReasonUserNotActive)
+	}
+	switch {
+	case p.Limit.Currency() == "" || p.Limit.IsZero():
+		reasons = append(reasons, ReasonNoCreditLimit)
+	case amount.Currency() != p.Limit.Currency():
+		reasons = append(reasons, ReasonCurrencyMismatch)
+	default:
+		if exceeds, err := amount.GreaterThan(p.Available); err != nil {
+			return Decision{}, err
+		} else if exceeds {
+			reasons = append(reasons, ReasonLimitExceeded)
+		}
+	}
+	if p.ForeignPayments > 0 {
+		reasons = append(reasons, ReasonForeignExposure)
+	}
+	if p.Blocked {
+		reasons = append(reasons, ReasonOverdueBlocked)
+	}
+
+	return Decision{Approved: len(reasons) == 0, Reasons: reasons, Profile: p}, nil
+}
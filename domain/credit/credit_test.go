@@ -0,0 +1,131 @@
+package credit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProfile_ExposureCountsUnpaidPrincipalAndPenalty(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	u := mustUser(t, base, mustKRW(t, 100_000))
+
+	upcoming := mustPayment(t, u, 30_000, base.AddDate(0, 0, 14), base)
+	paid := mustPayment(t, u, 20_000, base, base)
+	require.NoError(t, paid.Pay(base))
+	late := mustPayment(t, u, 10_000, base, base)
+	require.NoError(t, late.AccrueInterest(base.AddDate(0, 0, 2), 1_000)) // 1,000 + 1,100
+
+	profile, err := NewProfile(u, []*payment.Payment{upcoming, paid, late}, DefaultPolicy, base.AddDate(0, 0, 2))
+	require.NoError(t, err)
+	require.Equal(t, "42100", profile.Exposure.Amount().String())
+	require.Equal(t, "57900", profile.Available.Amount().String())
+	require.Equal(t, 1, profile.OverduePayments)
+	require.Equal(t, 2, profile.MaxDaysOverdue)
+	require.False(t, profile.Blocked)
+
+	decision, err := profile.Evaluate(mustKRW(t, 57_900))
+	require.NoError(t, err)
+	require.True(t, decision.Approved)
+
+	decision, err = profile.Evaluate(mustKRW(t, 57_901))
+	require.NoError(t, err)
+	require.False(t, decision.Approved)
+	require.Equal(t, []Reason{ReasonLimitExceeded}, decision.Reasons)
+}
+
+func TestNewProfile_BlocksWhenOverdueBeyondThreshold(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	u := mustUser(t, base, mustKRW(t, 100_000))
+	late := mustPayment(t, u, 10_000, base, base)
+
+	policy := Policy{BlockAfterDaysOverdue: 10}
+	profile, err := NewProfile(u, []*payment.Payment{late}, policy, base.AddDate(0, 0, 9))
+	require.NoError(t, err)
+	require.False(t, profile.Blocked)
+
+	profile, err = NewProfile(u, []*payment.Payment{late}, policy, base.AddDate(0, 0, 10))
+	require.NoError(t, err)
+	require.True(t, profile.Blocked)
+
+	decision, err := profile.Evaluate(mustKRW(t, 1_000_000))
+	require.NoError(t, err)
+	require.False(t, decision.Approved)
+	require.Equal(t, []Reason{ReasonLimitExceeded, ReasonOverdueBlocked}, decision.Reasons)
+}
+
+func TestEvaluate_WithoutLimitOrWrongCurrency(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	noLimit, err := user.New("tester", base)
+	require.NoError(t, err)
+
+	profile, err := NewProfile(noLimit, nil, DefaultPolicy, base)
+	require.NoError(t, err)
+	decision, err := profile.Evaluate(mustKRW(t, 1_000))
+	require.NoError(t, err)
+	require.Equal(t, []Reason{ReasonUserNotActive, ReasonNoCreditLimit}, decision.Reasons)
+
+	profile, err = NewProfile(mustUser(t, base, mustKRW(t, 100_000)), nil, DefaultPolicy, base)
+	require.NoError(t, err)
+	usd, err := money.FromMinor(1_000, money.CurrencyUSD)
+	require.NoError(t, err)
+	decision, err = profile.Evaluate(usd)
+	require.NoError(t, err)
+	require.Equal(t, []Reason{ReasonCurrencyMismatch}, decision.Reasons)
+
+	_, err = profile.Evaluate(mustKRW(t, 0))
+	require.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+func TestNewProfile_DeclinesUserWithForeignCurrencyPayment(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	u := mustUser(t, base, mustKRW(t, 100_000))
+
+	krw := mustPayment(t, u, 10_000, base.AddDate(0, 0, 14), base)
+	usdAmount, err := money.FromMinor(5_000, money.CurrencyUSD)
+	require.NoError(t, err)
+	usd, err := payment.New(u.ID(), usdAmount, base, base)
+	require.NoError(t, err)
+
+	profile, err := NewProfile(u, []*payment.Payment{krw, usd}, DefaultPolicy, base.AddDate(0, 0, 3))
+	require.NoError(t, err)
+	require.Equal(t, "10000", profile.Exposure.Amount().String())
+	require.Equal(t, 1, profile.ForeignPayments)
+	require.Equal(t, 1, profile.OverduePayments)
+	require.Equal(t, 3, profile.MaxDaysOverdue)
+
+	decision, err := profile.Evaluate(mustKRW(t, 1_000))
+	require.NoError(t, err)
+	require.False(t, decision.Approved)
+	require.Equal(t, []Reason{ReasonForeignExposure}, decision.Reasons)
+
+	require.NoError(t, usd.Pay(base.AddDate(0, 0, 3)))
+	profile, err = NewProfile(u, []*payment.Payment{krw, usd}, DefaultPolicy, base.AddDate(0, 0, 3))
+	require.NoError(t, err)
+	decision, err = profile.Evaluate(mustKRW(t, 1_000))
+	require.NoError(t, err)
+	require.True(t, decision.Approved)
+}
+
+func mustUser(t *testing.T, now time.Time, limit money.Money) *user.User {
+	u, err := user.New("tester", now, user.WithCreditLimit(limit))
+	require.NoError(t, err)
+	require.NoError(t, u.VerifyKYC(now))
+	return u
+}
+
+func mustPayment(t *testing.T, u *user.User, minor int64, dueDate, now time.Time) *payment.Payment {
+	p, err := payment.New(u.ID(), mustKRW(t, minor), dueDate, now)
+	require.NoError(t, err)
+	return p
+}
+
+func mustKRW(t *testing.T, minor int64) money.Money {
+	m, err := money.FromMinor(minor, money.CurrencyKRW)
+	require.NoError(t, err)
+	return m
+}
@@ -0,0 +1,103 @@
+package money
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Allocate distributes m across parts proportional to ratios without losing a
+// minor unit. Each share is truncated to currency scale and the leftover
+// minor units go one at a time to the parts with the largest truncated
+// remainder (earlier parts win ties), so the result is deterministic, always
+// sums to m and every part is within one minor unit of its exact share.
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, ErrInvalidRatios
+	}
+	total := int64(0)
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, ErrInvalidRatios
+		}
+		total += int64(r)
+	}
+	if total == 0 {
+		return nil, ErrInvalidRatios
+	}
+
+	// Work in minor units on the absolute value so truncation never overshoots.
+	units := m.amount.Abs().Shift(m.scale)
+	sum := decimal.NewFromInt(total)
+	shares := make([]decimal.Decimal, len(ratios))
+	remainders := make([]decimal.Decimal, len(ratios))
+	allocated := decimal.Zero
+	for i, r := range ratios {
+		shares[i], remainders[i] = units.Mul(decimal.NewFromInt(int64(r))).QuoRem(sum, 0)
+		allocated = allocated.Add(shares[i])
+	}
+
+	// Hand out leftover units by largest remainder; ties go to the earlier part.
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].GreaterThan(remainders[order[b]])
+	})
+	left := units.Sub(allocated).IntPart()
+	for _, i := range order[:left] {
+		shares[i] = shares[i].Add(decimal.NewFromInt(1))
+	}
+
+	parts := make([]Money, len(ratios))
+	for i, share := range shares {
+		parts[i] = m.fromUnits(share)
+	}
+	return parts, nil
+}
+
+// Split divides m into n parts that differ by at most one minor unit; the
+// earlier parts carry the extra units.
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, ErrInvalidRatios
+	}
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// SplitEven divides m into n equal parts truncated to currency scale and puts
+// every leftover minor unit on the part at index remainderAt, e.g. 0 or n-1 to
+// keep the odd amount on the first or last installment.
+func (m Money) SplitEven(n, remainderAt int) ([]Money, error) {
+	if n <= 0 || remainderAt < 0 || remainderAt >= n {
+		return nil, ErrInvalidRatios
+	}
+	units := m.amount.Abs().Shift(m.scale)
+	count := decimal.NewFromInt(int64(n))
+	share, _ := units.QuoRem(count, 0)
+	leftover := units.Sub(share.Mul(count))
+
+	parts := make([]Money, n)
+	for i := range parts {
+		part := share
+		if i == remainderAt {
+			part = part.Add(leftover)
+		}
+		parts[i] = m.fromUnits(part)
+	}
+	return parts, nil
+}
+
+// fromUnits builds a Money of m's currency and sign from a count of minor units.
+func (m Money) fromUnits(units decimal.Decimal) Money {
+	amount := units.Shift(-m.scale)
+	if m.amount.IsNegative() {
+		amount = amount.Neg()
+	}
+	return Money{amount: amount, currency: m.currency, scale: m.scale}
+}
@@ -0,0 +1,144 @@
+package money
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitDistributesRemainderToEarlierParts(t *testing.T) {
+	m, _ := FromMinor(10_000, CurrencyKRW)
+
+	parts, err := m.Split(3)
+	require.NoError(t, err)
+	require.Equal(t, "3334", parts[0].Amount().String())
+	require.Equal(t, "3333", parts[1].Amount().String())
+	require.Equal(t, "3333", parts[2].Amount().String())
+}
+
+func TestSplitEvenKeepsRemainderOnOnePart(t *testing.T) {
+	m, _ := FromMinor(10_002, CurrencyUSD) // $100.02
+
+	parts, err := m.SplitEven(4, 3)
+	require.NoError(t, err)
+	require.Equal(t, "25.00", parts[0].Amount().StringFixed(2))
+	require.Equal(t, "25.00", parts[2].Amount().StringFixed(2))
+	require.Equal(t, "25.02", parts[3].Amount().StringFixed(2))
+
+	parts, err = m.SplitEven(4, 0)
+	require.NoError(t, err)
+	require.Equal(t, "25.02", parts[0].Amount().StringFixed(2))
+	require.Equal(t, "25.00", parts[3].Amount().StringFixed(2))
+
+	_, err = m.SplitEven(4, 4)
+	require.ErrorIs(t, err, ErrInvalidRatios)
+	_, err = m.SplitEven(0, 0)
+	require.ErrorIs(t, err, ErrInvalidRatios)
+}
+
+func TestAllocateByRatios(t *testing.T) {
+	m, _ := FromMinor(5, CurrencyUSD) // $0.05
+
+	parts, err := m.Allocate(3, 7)
+	require.NoError(t, err)
+	require.Equal(t, "0.02", parts[0].Amount().StringFixed(2))
+	require.Equal(t, "0.03", parts[1].Amount().StringFixed(2))
+
+	parts, err = m.Allocate(0, 1, 1)
+	require.NoError(t, err)
+	require.True(t, parts[0].IsZero())
+	require.Equal(t, "0.03", parts[1].Amount().StringFixed(2))
+	require.Equal(t, "0.02", parts[2].Amount().StringFixed(2))
+}
+
+func TestAllocateNegativeAmount(t *testing.T) {
+	m, _ := FromMinor(-10_000, CurrencyKRW)
+
+	parts, err := m.Split(3)
+	require.NoError(t, err)
+	require.Equal(t, "-3334", parts[0].Amount().String())
+	require.Equal(t, "-3333", parts[2].Amount().String())
+}
+
+func TestAllocateRejectsInvalidRatios(t *testing.T) {
+	m, _ := FromMinor(100, CurrencyKRW)
+
+	_, err := m.Allocate()
+	require.ErrorIs(t, err, ErrInvalidRatios)
+	_, err = m.Allocate(0, 0)
+	require.ErrorIs(t, err, ErrInvalidRatios)
+	_, err = m.Allocate(1, -1)
+	require.ErrorIs(t, err, ErrInvalidRatios)
+	_, err = m.Split(0)
+	require.ErrorIs(t, err, ErrInvalidRatios)
+}
+
+// TestAllocateConservesAmountForEveryCurrency checks that parts always sum to
+// the original and stay within one minor unit of the exact share, across every
+// registered currency scale.
+func TestAllocateConservesAmountForEveryCurrency(t *testing.T) {
+	rng := rand.New(rand.NewSource(33))
+
+	for _, info := range DefaultRegistry().Currencies() {
+		unit := decimal.New(1, -info.MinorUnits)
+		for i := 0; i < 50; i++ {
+			m, err := FromMinor(rng.Int63n(1_000_000_000_000)-100_000_000_000, info.Code)
+			require.NoError(t, err)
+
+			ratios := make([]int, 1+rng.Intn(12))
+			total := 0
+			for j := range ratios {
+				ratios[j] = rng.Intn(100)
+				total += ratios[j]
+			}
+			if total == 0 {
+				ratios[0] = 1
+				total = 1
+			}
+
+			parts, err := m.Allocate(ratios...)
+			require.NoError(t, err)
+			require.Len(t, parts, len(ratios))
+
+			sum, err := Zero(info.Code)
+			require.NoError(t, err)
+			for j, part := range parts {
+				require.Equal(t, info.Code, part.Currency())
+				require.True(t, part.Amount().Equal(part.Amount().Round(info.MinorUnits)), "part exceeds scale")
+
+				exact := m.Amount().Mul(decimal.NewFromInt(int64(ratios[j]))).Div(decimal.NewFromInt(int64(total)))
+				require.True(t, part.Amount().Sub(exact).Abs().LessThan(unit), "%s ratio %v part %s", m, ratios, part)
+
+				sum, err = sum.Add(part)
+				require.NoError(t, err)
+			}
+			require.True(t, sum.Amount().Equal(m.Amount()), "%s allocated by %v sums to %s", m, ratios, sum)
+		}
+	}
+}
+
+func TestSplitConservesAmountForEveryCurrency(t *testing.T) {
+	rng := rand.New(rand.NewSource(34))
+
+	for _, info := range DefaultRegistry().Currencies() {
+		for i := 0; i < 20; i++ {
+			m, err := FromMinor(rng.Int63n(10_000_000), info.Code)
+			require.NoError(t, err)
+			n := 1 + rng.Intn(24)
+
+			parts, err := m.Split(n)
+			require.NoError(t, err)
+
+			sum, _ := Zero(info.Code)
+			for _, part := range parts {
+				sum, err = sum.Add(part)
+				require.NoError(t, err)
+			}
+			require.True(t, sum.Amount().Equal(m.Amount()))
+			spread := parts[0].Amount().Sub(parts[n-1].Amount())
+			require.True(t, spread.LessThanOrEqual(decimal.New(1, -info.MinorUnits)))
+		}
+	}
+}
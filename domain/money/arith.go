@@ -0,0 +1,123 @@
+package money
+
+import "github.com/shopspring/decimal"
+
+// divPrecision is the intermediate precision used before rounding a quotient
+// to currency scale.
+const divPrecision = 16
+
+// Cmp compares two amounts of the same currency: -1 if m < other, 0 if equal,
+// +1 if m > other.
+func (m Money) Cmp(other Money) (int, error) {
+	if m.currency != other.currency {
+		return 0, ErrCurrencyMismatch
+	}
+	return m.amount.Cmp(other.amount), nil
+}
+
+// Equal reports whether both amount and currency match.
+func (m Money) Equal(other Money) bool {
+	return m.currency == other.currency && m.amount.Equal(other.amount)
+}
+
+func (m Money) LessThan(other Money) (bool, error) {
+	c, err := m.Cmp(other)
+	return c < 0, err
+}
+
+func (m Money) GreaterThan(other Money) (bool, error) {
+	c, err := m.Cmp(other)
+	return c > 0, err
+}
+
+func (m Money) IsNegative() bool {
+	return m.amount.Sign() < 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.amount.Sign() > 0
+}
+
+func (m Money) Neg() Money {
+	return Money{amount: m.amount.Neg(), currency: m.currency, scale: m.scale}
+}
+
+func (m Money) Abs() Money {
+	return Money{amount: m.amount.Abs(), currency: m.currency, scale: m.scale}
+}
+
+// Mul multiplies by factor with half-up rounding at currency scale.
+func (m Money) Mul(factor decimal.Decimal) Money {
+	return m.mulRounded(factor, RoundHalfUp)
+}
+
+// MulRounded multiplies by factor and rounds once with the given mode. An
+// unknown mode fails with ErrInvalidRoundingMode, as in Div.
+func (m Money) MulRounded(factor decimal.Decimal, mode RoundingMode) (Money, error) {
+	if !mode.IsValid() {
+		return Money{}, ErrInvalidRoundingMode
+	}
+	return m.mulRounded(factor, mode), nil
+}
+
+func (m Money) mulRounded(factor decimal.Decimal, mode RoundingMode) Money {
+	return Money{amount: mode.Round(m.amount.Mul(factor), m.scale), currency: m.currency, scale: m.scale}
+}
+
+// Div divides by divisor and rounds the quotient with the given mode. Use
+// Allocate or Split when the parts must add back up to m.
+func (m Money) Div(divisor decimal.Decimal, mode RoundingMode) (Money, error) {
+	if divisor.IsZero() {
+		return Money{}, ErrDivisionByZero
+	}
+	if !mode.IsValid() {
+		return Money{}, ErrInvalidRoundingMode
+	}
+	q := m.amount.DivRound(divisor, m.scale+divPrecision)
+	return Money{amount: mode.Round(q, m.scale), currency: m.currency, scale: m.scale}, nil
+}
+
+// Min returns the smallest of the amounts, which must share a currency.
+func Min(first Money, rest ...Money) (Money, error) {
+	out := first
+	for _, m := range rest {
+		less, err := m.LessThan(out)
+		if err != nil {
+			return Money{}, err
+		}
+		if less {
+			out = m
+		}
+	}
+	return out, nil
+}
+
+// Max returns the largest of the amounts, which must share a currency.
+func Max(first Money, rest ...Money) (Money, error) {
+	out := first
+	for _, m := range rest {
+		greater, err := m.GreaterThan(out)
+		if err != nil {
+			return Money{}, err
+		}
+		if greater {
+			out = m
+		}
+	}
+	return out, nil
+}
+
+// Sum adds the amounts; it needs at least one to know the currency.
+func Sum(ms ...Money) (Money, error) {
+	if len(ms) == 0 {
+		return Money{}, ErrEmptySum
+	}
+	total := ms[0]
+	for _, m := range ms[1:] {
+		var err error
+		if total, err = total.Add(m); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
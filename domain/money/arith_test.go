@@ -0,0 +1,128 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareRequiresSameCurrency(t *testing.T) {
+	a, _ := FromMinor(1_000, CurrencyKRW)
+	b, _ := FromMinor(2_000, CurrencyKRW)
+	usd, _ := FromMinor(1_000, CurrencyUSD)
+
+	c, err := a.Cmp(b)
+	require.NoError(t, err)
+	require.Equal(t, -1, c)
+
+	less, err := a.LessThan(b)
+	require.NoError(t, err)
+	require.True(t, less)
+
+	greater, err := a.GreaterThan(b)
+	require.NoError(t, err)
+	require.False(t, greater)
+
+	_, err = a.Cmp(usd)
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+	require.False(t, a.Equal(usd))
+}
+
+func TestSignHelpers(t *testing.T) {
+	m, _ := FromMinor(-1_250, CurrencyUSD)
+	require.True(t, m.IsNegative())
+	require.False(t, m.IsPositive())
+	require.Equal(t, "12.50", m.Abs().Amount().StringFixed(2))
+	require.True(t, m.Neg().Equal(m.Abs()))
+}
+
+func TestMulAndDivRoundAtCurrencyScale(t *testing.T) {
+	m, _ := FromMinor(1_000, CurrencyUSD) // $10.00
+
+	require.Equal(t, "3.33", m.Mul(decimal.RequireFromString("0.333")).Amount().StringFixed(2))
+
+	third, err := m.Div(decimal.NewFromInt(3), RoundHalfUp)
+	require.NoError(t, err)
+	require.Equal(t, "3.33", third.Amount().StringFixed(2))
+
+	up, err := m.Div(decimal.NewFromInt(3), RoundUp)
+	require.NoError(t, err)
+	require.Equal(t, "3.34", up.Amount().StringFixed(2))
+
+	_, err = m.Div(decimal.Zero, RoundHalfUp)
+	require.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestMinMaxSum(t *testing.T) {
+	a, _ := FromMinor(300, CurrencyKRW)
+	b, _ := FromMinor(100, CurrencyKRW)
+	c, _ := FromMinor(200, CurrencyKRW)
+	usd, _ := FromMinor(1, CurrencyUSD)
+
+	lo, err := Min(a, b, c)
+	require.NoError(t, err)
+	require.True(t, lo.Equal(b))
+
+	hi, err := Max(a, b, c)
+	require.NoError(t, err)
+	require.True(t, hi.Equal(a))
+
+	total, err := Sum(a, b, c)
+	require.NoError(t, err)
+	require.Equal(t, "600", total.Amount().String())
+
+	_, err = Sum()
+	require.ErrorIs(t, err, ErrEmptySum)
+	_, err = Sum(a, usd)
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = Max(a, usd)
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+}
+
+func TestContextNonNegativeRejectsNegativeResults(t *testing.T) {
+	ctx := Context{Rounding: RoundHalfUp, NonNegative: true}
+	balance, _ := FromMinor(1_000, CurrencyKRW)
+	refund, _ := FromMinor(1_500, CurrencyKRW)
+
+	_, err := ctx.Sub(balance, refund)
+	require.ErrorIs(t, err, ErrNegativeAmount)
+
+	_, err = ctx.New(decimal.NewFromInt(-1), CurrencyKRW)
+	require.ErrorIs(t, err, ErrNegativeAmount)
+
+	left, err := ctx.Sub(refund, balance)
+	require.NoError(t, err)
+	require.Equal(t, "500", left.Amount().String())
+
+	_, err = ctx.MulBPS(balance, -125)
+	require.ErrorIs(t, err, ErrNegativeAmount)
+	_, err = ctx.MulRate(balance, RateFromFraction(decimal.RequireFromString("-0.01")))
+	require.ErrorIs(t, err, ErrNegativeAmount)
+	_, err = ctx.Mul(balance, decimal.NewFromInt(-1))
+	require.ErrorIs(t, err, ErrNegativeAmount)
+	reduced, err := ctx.ApplyBPS(balance, -125)
+	require.NoError(t, err)
+	require.Equal(t, "987", reduced.Amount().String())
+
+	lenient := Context{Rounding: RoundHalfUp}
+	owed, err := lenient.Sub(balance, refund)
+	require.NoError(t, err)
+	require.True(t, owed.IsNegative())
+	negative, err := lenient.MulBPS(balance, -125)
+	require.NoError(t, err)
+	require.Equal(t, "-13", negative.Amount().String())
+}
+
+func TestMulRoundedRejectsInvalidMode(t *testing.T) {
+	m, _ := FromMinor(1_000, CurrencyKRW)
+
+	_, err := m.MulRounded(decimal.RequireFromString("0.5"), "BANKERS")
+	require.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, err = Context{Rounding: "BANKERS"}.MulBPS(m, 10)
+	require.ErrorIs(t, err, ErrInvalidRoundingMode)
+
+	half, err := m.MulRounded(decimal.RequireFromString("0.0005"), RoundHalfEven)
+	require.NoError(t, err)
+	require.Equal(t, "0", half.Amount().String())
+}
@@ -0,0 +1,126 @@
+package money
+
+import "sync"
+
+// Currency expects an ISO-like currency identifier.
+type Currency string
+
+const (
+	CurrencyEUR Currency = "EUR"
+	CurrencyJPY Currency = "JPY"
+	CurrencyKRW Currency = "KRW"
+	CurrencyUSD Currency = "USD"
+	CurrencyVND Currency = "VND"
+)
+
+// CurrencyInfo describes a currency as listed in ISO 4217.
+type CurrencyInfo struct {
+	Code       Currency
+	Numeric    string
+	MinorUnits int32
+	Symbol     string
+}
+
+const maxMinorUnits = 18
+
+func (i CurrencyInfo) validate() error {
+	if len(i.Code) != 3 {
+		return ErrInvalidCurrency
+	}
+	for _, r := range i.Code {
+		if r < 'A' || r > 'Z' {
+			return ErrInvalidCurrency
+		}
+	}
+	if i.MinorUnits < 0 || i.MinorUnits > maxMinorUnits {
+		return ErrInvalidCurrency
+	}
+	return nil
+}
+
+// Registry resolves currency codes to their ISO 4217 metadata.
+type Registry struct {
+	mu     sync.RWMutex
+	byCode map[Currency]CurrencyInfo
+}
+
+// NewRegistry builds a registry from the given currencies. Invalid or
+// duplicate entries are reported as errors.
+func NewRegistry(currencies ...CurrencyInfo) (*Registry, error) {
+	r := &Registry{byCode: make(map[Currency]CurrencyInfo, len(currencies))}
+	for _, c := range currencies {
+		if err := r.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Register adds a custom currency. Existing codes cannot be redefined.
+func (r *Registry) Register(info CurrencyInfo) error {
+	if err := info.validate(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.byCode[info.Code]; ok {
+		return ErrCurrencyAlreadyRegistered
+	}
+	r.byCode[info.Code] = info
+	return nil
+}
+
+// Lookup returns the metadata for a currency code.
+func (r *Registry) Lookup(c Currency) (CurrencyInfo, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	info, ok := r.byCode[c]
+	if !ok {
+		return CurrencyInfo{}, ErrInvalidCurrency
+	}
+	return info, nil
+}
+
+// Currencies lists every registered currency in no particular order.
+func (r *Registry) Currencies() []CurrencyInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]CurrencyInfo, 0, len(r.byCode))
+	for _, info := range r.byCode {
+		out = append(out, info)
+	}
+	return out
+}
+
+var defaultRegistry = mustRegistry(iso4217...)
+
+func mustRegistry(currencies ...CurrencyInfo) *Registry {
+	r, err := NewRegistry(currencies...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// DefaultRegistry is the process-wide registry used by New, FromMinor and Zero.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register adds a custom currency to the default registry; call it at startup.
+func Register(info CurrencyInfo) error {
+	return defaultRegistry.Register(info)
+}
+
+// Lookup resolves a currency code against the default registry.
+func Lookup(c Currency) (CurrencyInfo, error) {
+	return defaultRegistry.Lookup(c)
+}
+
+func currencyScale(c Currency) (int32, error) {
+	info, err := defaultRegistry.Lookup(c)
+	if err != nil {
+		return 0, err
+	}
+	return info.MinorUnits, nil
+}
@@ -0,0 +1,59 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupISOCurrencies(t *testing.T) {
+	cases := []struct {
+		code    Currency
+		numeric string
+		scale   int32
+		symbol  string
+	}{
+		{CurrencyKRW, "410", 0, "₩"},
+		{CurrencyUSD, "840", 2, "$"},
+		{CurrencyJPY, "392", 0, "¥"},
+		{CurrencyEUR, "978", 2, "€"},
+		{CurrencyVND, "704", 0, "₫"},
+		{"BHD", "048", 3, ".د.ب"},
+	}
+	for _, tc := range cases {
+		info, err := Lookup(tc.code)
+		require.NoError(t, err, tc.code)
+		require.Equal(t, tc.numeric, info.Numeric)
+		require.Equal(t, tc.scale, info.MinorUnits)
+		require.Equal(t, tc.symbol, info.Symbol)
+	}
+
+	_, err := Lookup("XXX")
+	require.ErrorIs(t, err, ErrInvalidCurrency)
+}
+
+func TestNewUsesRegistryScale(t *testing.T) {
+	yen, err := New(decimal.RequireFromString("1234.5"), CurrencyJPY)
+	require.NoError(t, err)
+	require.Equal(t, "1235", yen.Amount().String())
+
+	dinar, err := FromMinor(12_345, "KWD")
+	require.NoError(t, err)
+	require.Equal(t, "12.345 KWD", dinar.String())
+}
+
+func TestRegistryRegisterCustomCurrency(t *testing.T) {
+	r, err := NewRegistry(CurrencyInfo{Code: CurrencyKRW, Numeric: "410", MinorUnits: 0, Symbol: "₩"})
+	require.NoError(t, err)
+
+	require.NoError(t, r.Register(CurrencyInfo{Code: "PTS", MinorUnits: 4, Symbol: "pt"}))
+	info, err := r.Lookup("PTS")
+	require.NoError(t, err)
+	require.Equal(t, int32(4), info.MinorUnits)
+	require.Len(t, r.Currencies(), 2)
+
+	require.ErrorIs(t, r.Register(CurrencyInfo{Code: CurrencyKRW}), ErrCurrencyAlreadyRegistered)
+	require.ErrorIs(t, r.Register(CurrencyInfo{Code: "pts"}), ErrInvalidCurrency)
+	require.ErrorIs(t, r.Register(CurrencyInfo{Code: "ABC", MinorUnits: -1}), ErrInvalidCurrency)
+}
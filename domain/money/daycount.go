@@ -0,0 +1,54 @@
+package money
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DayCount is the convention that turns an annual rate into a daily one.
+type DayCount string
+
+const (
+	// DayCountACT365 divides by 365 in every year (ACT/365 Fixed); the default.
+	DayCountACT365 DayCount = "ACT/365"
+	// DayCountACT360 divides by 360, common for money-market reference rates.
+	DayCountACT360 DayCount = "ACT/360"
+	// DayCountACTACT divides by the length of the calendar year, 366 in leap years.
+	DayCountACTACT DayCount = "ACT/ACT"
+)
+
+// IsValid reports whether the convention is known; empty means DayCountACT365.
+func (d DayCount) IsValid() bool {
+	switch d {
+	case "", DayCountACT365, DayCountACT360, DayCountACTACT:
+		return true
+	default:
+		return false
+	}
+}
+
+// DaysInYear is the divisor the convention uses for a day on date.
+func (d DayCount) DaysInYear(date time.Time) (int, error) {
+	switch d {
+	case "", DayCountACT365:
+		return 365, nil
+	case DayCountACT360:
+		return 360, nil
+	case DayCountACTACT:
+		year := date.In(time.UTC).Year()
+		return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay(), nil
+	default:
+		return 0, ErrInvalidDayCount
+	}
+}
+
+// DailyRate converts an annual percentage rate (3.5 = 3.5%) into the daily
+// rate for date under the convention.
+func (d DayCount) DailyRate(aprPercent decimal.Decimal, date time.Time) (Rate, error) {
+	days, err := d.DaysInYear(date)
+	if err != nil {
+		return Rate{}, err
+	}
+	return DailyRateFromAPR(aprPercent, days)
+}
@@ -0,0 +1,111 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// NewExact builds Money only when amount already fits the currency scale,
+// rejecting input that New would silently round.
+func NewExact(amount decimal.Decimal, currency Currency) (Money, error) {
+	scale, err := currencyScale(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	if !amount.Equal(amount.Truncate(scale)) {
+		return Money{}, ErrPrecisionExceeded
+	}
+	return Money{amount: amount.Truncate(scale), currency: currency, scale: scale}, nil
+}
+
+type moneyJSON struct {
+	Amount   string   `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+// MarshalJSON encodes Money as {"amount":"1000","currency":"KRW"}, keeping the
+// amount a string so no precision is lost in JSON numbers.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m.currency == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(moneyJSON{Amount: m.amount.StringFixed(m.scale), Currency: m.currency})
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*m = Money{}
+		return nil
+	}
+	var raw moneyJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	return m.parse(raw.Amount, raw.Currency)
+}
+
+// MarshalText encodes Money as "<amount> <currency>", matching String.
+func (m Money) MarshalText() ([]byte, error) {
+	if m.currency == "" {
+		return nil, ErrInvalidCurrency
+	}
+	return []byte(m.String()), nil
+}
+
+func (m *Money) UnmarshalText(text []byte) error {
+	amount, currency, ok := strings.Cut(strings.TrimSpace(string(text)), " ")
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrInvalidMoneyText, text)
+	}
+	return m.parse(amount, Currency(strings.TrimSpace(currency)))
+}
+
+// Value stores Money in a single text column using the MarshalText format.
+func (m Money) Value() (driver.Value, error) {
+	if m.currency == "" {
+		return nil, nil
+	}
+	return m.String(), nil
+}
+
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case string:
+		return m.UnmarshalText([]byte(v))
+	case []byte:
+		return m.UnmarshalText(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidMoneyText, src)
+	}
+}
+
+func (m *Money) parse(amount string, currency Currency) error {
+	dec, err := decimal.NewFromString(amount)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMoneyText, err)
+	}
+	parsed, err := NewExact(dec, currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+var (
+	_ json.Marshaler           = Money{}
+	_ json.Unmarshaler         = (*Money)(nil)
+	_ encoding.TextMarshaler   = Money{}
+	_ encoding.TextUnmarshaler = (*Money)(nil)
+	_ driver.Valuer            = Money{}
+	_ sql.Scanner              = (*Money)(nil)
+)
@@ -0,0 +1,76 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoneyJSONRoundTrip(t *testing.T) {
+	m, _ := FromMinor(1250, CurrencyUSD)
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":"12.50","currency":"USD"}`, string(data))
+
+	var decoded Money
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, CurrencyUSD, decoded.Currency())
+	require.True(t, decoded.Amount().Equal(m.Amount()))
+
+	krw, _ := FromMinor(1000, CurrencyKRW)
+	data, err = json.Marshal(krw)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":"1000","currency":"KRW"}`, string(data))
+}
+
+func TestMoneyJSONRejectsExcessPrecision(t *testing.T) {
+	var m Money
+	err := json.Unmarshal([]byte(`{"amount":"1000.5","currency":"KRW"}`), &m)
+	require.ErrorIs(t, err, ErrPrecisionExceeded)
+
+	err = json.Unmarshal([]byte(`{"amount":"10","currency":"XXX"}`), &m)
+	require.ErrorIs(t, err, ErrInvalidCurrency)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"12.500","currency":"USD"}`), &m))
+	require.Equal(t, "12.50 USD", m.String())
+}
+
+func TestMoneyJSONNull(t *testing.T) {
+	var payload struct {
+		Penalty Money `json:"penalty"`
+	}
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"penalty":null}`, string(data))
+	require.NoError(t, json.Unmarshal(data, &payload))
+	require.Equal(t, Currency(""), payload.Penalty.Currency())
+}
+
+func TestMoneyTextAndSQLRoundTrip(t *testing.T) {
+	m, _ := New(decimal.RequireFromString("12.34"), CurrencyEUR)
+
+	text, err := m.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "12.34 EUR", string(text))
+
+	var fromText Money
+	require.NoError(t, fromText.UnmarshalText(text))
+	require.True(t, fromText.Amount().Equal(m.Amount()))
+
+	v, err := m.Value()
+	require.NoError(t, err)
+
+	var scanned Money
+	require.NoError(t, scanned.Scan(v))
+	require.Equal(t, m.String(), scanned.String())
+	require.NoError(t, scanned.Scan([]byte("5 KRW")))
+	require.Equal(t, CurrencyKRW, scanned.Currency())
+
+	require.ErrorIs(t, scanned.Scan("12"), ErrInvalidMoneyText)
+	require.ErrorIs(t, scanned.Scan(42), ErrInvalidMoneyText)
+	require.NoError(t, scanned.Scan(nil))
+	require.Equal(t, Currency(""), scanned.Currency())
+}
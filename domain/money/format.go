@@ -0,0 +1,211 @@
+package money
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/shopspring/decimal"
+)
+
+// Locale selects separators, symbol placement and symbol aliases for
+// Format and Parse.
+type Locale string
+
+const (
+	LocaleKoKR Locale = "ko-KR"
+	LocaleEnUS Locale = "en-US"
+	LocaleJaJP Locale = "ja-JP"
+	LocaleDeDE Locale = "de-DE"
+)
+
+type localeFormat struct {
+	group       string
+	decimal     string
+	symbolAfter bool
+	// fallback is the currency assumed when parsed text carries no marker.
+	fallback Currency
+	// symbols are the display symbols Format uses; currencies not listed are
+	// rendered with their ISO code so the output never becomes ambiguous.
+	symbols map[Currency]string
+	// aliases are extra markers accepted by Parse, such as "원" for KRW.
+	aliases map[string]Currency
+}
+
+var commonSymbols = map[Currency]string{
+	CurrencyKRW: "₩",
+	CurrencyUSD: "$",
+	CurrencyJPY: "¥",
+	CurrencyEUR: "€",
+}
+
+var locales = map[Locale]localeFormat{
+	LocaleKoKR: {group: ",", decimal: ".", fallback: CurrencyKRW, symbols: commonSymbols,
+		aliases: map[string]Currency{"원": CurrencyKRW, "엔": CurrencyJPY, "달러": CurrencyUSD, "유로": CurrencyEUR}},
+	LocaleEnUS: {group: ",", decimal: ".", fallback: CurrencyUSD, symbols: commonSymbols,
+		aliases: map[string]Currency{"US$": CurrencyUSD}},
+	LocaleJaJP: {group: ",", decimal: ".", fallback: CurrencyJPY, symbols: commonSymbols,
+		aliases: map[string]Currency{"￥": CurrencyJPY, "円": CurrencyJPY}},
+	LocaleDeDE: {group: ".", decimal: ",", symbolAfter: true, fallback: CurrencyEUR, symbols: commonSymbols},
+}
+
+// Format renders m for display in the locale, e.g. "₩1,000" (ko-KR) or
+// "1.000,50 €" (de-DE). Currencies without a locale symbol use the ISO code.
+func Format(m Money, locale Locale) (string, error) {
+	lf, ok := locales[locale]
+	if !ok {
+		return "", ErrUnsupportedLocale
+	}
+	if m.currency == "" {
+		return "", ErrInvalidCurrency
+	}
+
+	digits := m.amount.Abs().StringFixed(m.scale)
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	number := groupDigits(intPart, lf.group)
+	if fracPart != "" {
+		number += lf.decimal + fracPart
+	}
+
+	symbol, ok := lf.symbols[m.currency]
+	var out string
+	switch {
+	case lf.symbolAfter:
+		if !ok {
+			symbol = string(m.currency)
+		}
+		out = number + " " + symbol
+	case ok:
+		out = symbol + number
+	default:
+		out = string(m.currency) + " " + number
+	}
+	if m.amount.Sign() < 0 {
+		out = "-" + out
+	}
+	return out, nil
+}
+
+// Parse reads a localized amount such as "1,000원", "$12.50" or "1.000,50 €".
+// The currency comes from a symbol, alias or ISO code at either end and
+// defaults to the locale's currency when absent. Grouping must be well formed
+// and the fraction must fit the currency scale (ErrPrecisionExceeded).
+func Parse(s string, locale Locale) (Money, error) {
+	lf, ok := locales[locale]
+	if !ok {
+		return Money{}, ErrUnsupportedLocale
+	}
+
+	text := strings.TrimFunc(s, unicode.IsSpace)
+	negative := strings.HasPrefix(text, "-")
+	if negative {
+		text = strings.TrimFunc(text[1:], unicode.IsSpace)
+	}
+
+	currency, number := lf.fallback, text
+	if c, rest, found := cutMarker(text, lf); found {
+		currency, number = c, rest
+	}
+
+	amount, err := parseNumber(number, lf)
+	if err != nil {
+		return Money{}, err
+	}
+	if negative {
+		amount = amount.Neg()
+	}
+	return NewExact(amount, currency)
+}
+
+// cutMarker strips a currency marker from the start or end of text.
+func cutMarker(text string, lf localeFormat) (Currency, string, bool) {
+	markers := make(map[string]Currency, len(lf.symbols)+len(lf.aliases))
+	for c, sym := range lf.symbols {
+		markers[sym] = c
+	}
+	for alias, c := range lf.aliases {
+		markers[alias] = c
+	}
+	keys := make([]string, 0, len(markers))
+	for k := range markers {
+		keys = append(keys, k)
+	}
+	// longest first so "US$" wins over "$"
+	sort.Slice(keys, func(i, j int) bool { return len(keys[i]) > len(keys[j]) })
+
+	for _, k := range keys {
+		if rest, ok := strings.CutPrefix(text, k); ok {
+			return markers[k], strings.TrimFunc(rest, unicode.IsSpace), true
+		}
+		if rest, ok := strings.CutSuffix(text, k); ok {
+			return markers[k], strings.TrimFunc(rest, unicode.IsSpace), true
+		}
+	}
+
+	if len(text) > 3 {
+		if code := Currency(text[:3]); isCurrencyCode(code) {
+			return code, strings.TrimFunc(text[3:], unicode.IsSpace), true
+		}
+		if code := Currency(text[len(text)-3:]); isCurrencyCode(code) {
+			return code, strings.TrimFunc(text[:len(text)-3], unicode.IsSpace), true
+		}
+	}
+	return "", text, false
+}
+
+func isCurrencyCode(c Currency) bool {
+	_, err := Lookup(c)
+	return err == nil
+}
+
+// parseNumber accepts digits with optional well-formed grouping and at most
+// one decimal separator.
+func parseNumber(s string, lf localeFormat) (decimal.Decimal, error) {
+	intPart, fracPart, hasFrac := strings.Cut(s, lf.decimal)
+	if hasFrac && (fracPart == "" || !allDigits(fracPart)) {
+		return decimal.Decimal{}, ErrInvalidMoneyText
+	}
+
+	groups := strings.Split(intPart, lf.group)
+	for i, g := range groups {
+		valid := allDigits(g) && g != ""
+		if len(groups) > 1 {
+			valid = valid && (i == 0 && len(g) <= 3 || i > 0 && len(g) == 3)
+		}
+		if !valid {
+			return decimal.Decimal{}, ErrInvalidMoneyText
+		}
+	}
+
+	plain := strings.Join(groups, "")
+	if hasFrac {
+		plain += "." + fracPart
+	}
+	return decimal.NewFromString(plain)
+}
+
+func allDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func groupDigits(digits, sep string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	head := len(digits) % 3
+	if head == 0 {
+		head = 3
+	}
+	var b strings.Builder
+	b.WriteString(digits[:head])
+	for i := head; i < len(digits); i += 3 {
+		b.WriteString(sep)
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
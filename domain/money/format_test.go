@@ -0,0 +1,86 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatLocales(t *testing.T) {
+	krw, _ := FromMinor(1_234_567, CurrencyKRW)
+	usd, _ := FromMinor(-123_450, CurrencyUSD)
+	eur, _ := FromMinor(100_050, CurrencyEUR)
+	gbp, _ := FromMinor(1_250, "GBP")
+
+	cases := []struct {
+		m      Money
+		locale Locale
+		want   string
+	}{
+		{krw, LocaleKoKR, "₩1,234,567"},
+		{krw, LocaleDeDE, "1.234.567 ₩"},
+		{krw, LocaleJaJP, "₩1,234,567"},
+		{usd, LocaleEnUS, "-$1,234.50"},
+		{usd, LocaleDeDE, "-1.234,50 $"},
+		{eur, LocaleDeDE, "1.000,50 €"},
+		{eur, LocaleEnUS, "€1,000.50"},
+		{gbp, LocaleEnUS, "GBP 12.50"},
+		{gbp, LocaleDeDE, "12,50 GBP"},
+	}
+	for _, tc := range cases {
+		got, err := Format(tc.m, tc.locale)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got)
+
+		back, err := Parse(got, tc.locale)
+		require.NoError(t, err)
+		require.Equal(t, tc.m, back)
+	}
+
+	_, err := Format(krw, "fr-FR")
+	require.ErrorIs(t, err, ErrUnsupportedLocale)
+}
+
+func TestParseBankFormats(t *testing.T) {
+	cases := []struct {
+		in       string
+		locale   Locale
+		amount   string
+		currency Currency
+	}{
+		{"1,000원", LocaleKoKR, "1000", CurrencyKRW},
+		{"₩ 1,000", LocaleKoKR, "1000", CurrencyKRW},
+		{"$12.50", LocaleKoKR, "12.5", CurrencyUSD},
+		{"$12.50", LocaleEnUS, "12.5", CurrencyUSD},
+		{"US$ 1,200", LocaleEnUS, "1200", CurrencyUSD},
+		{"12,000円", LocaleJaJP, "12000", CurrencyJPY},
+		{"¥500", LocaleJaJP, "500", CurrencyJPY},
+		{"1.000 ₩", LocaleDeDE, "1000", CurrencyKRW},
+		{"1.000,5 €", LocaleDeDE, "1000.5", CurrencyEUR},
+		{"USD 3.10", LocaleKoKR, "3.1", CurrencyUSD},
+		{"2500", LocaleKoKR, "2500", CurrencyKRW},
+	}
+	for _, tc := range cases {
+		m, err := Parse(tc.in, tc.locale)
+		require.NoError(t, err, tc.in)
+		require.Equal(t, tc.currency, m.Currency(), tc.in)
+		require.True(t, m.Amount().Equal(decimal.RequireFromString(tc.amount)), tc.in)
+	}
+}
+
+func TestParseRejectsMalformedInput(t *testing.T) {
+	_, err := Parse("$12.505", LocaleEnUS)
+	require.ErrorIs(t, err, ErrPrecisionExceeded)
+
+	_, err = Parse("1,000.5원", LocaleKoKR)
+	require.ErrorIs(t, err, ErrPrecisionExceeded)
+
+	for _, in := range []string{"", "$", "1,00", "1,0000", "12.5.0", "1.000,50 €", "12..5", "abc"} {
+		_, err = Parse(in, LocaleEnUS)
+		require.Error(t, err, in)
+	}
+
+	_, err = Parse("1000", "fr-FR")
+	require.ErrorIs(t, err, ErrUnsupportedLocale)
+}
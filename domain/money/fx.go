@@ -0,0 +1,129 @@
+package money
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// FXRate converts one unit of From into Rate units of To from EffectiveAt on.
+type FXRate struct {
+	From        Currency
+	To          Currency
+	Rate        decimal.Decimal
+	EffectiveAt time.Time
+	// Source identifies where the rate came from, e.g. a feed or file name.
+	Source string
+}
+
+func (r FXRate) validate() error {
+	if r.From == "" || r.To == "" || r.From == r.To || !r.Rate.IsPositive() || r.EffectiveAt.IsZero() {
+		return ErrInvalidFXRate
+	}
+	return nil
+}
+
+// FXRateProvider resolves the rate in effect for a currency pair at a time.
+type FXRateProvider interface {
+	Rate(from, to Currency, at time.Time) (FXRate, error)
+}
+
+// Conversion keeps the converted amount together with the rate that produced
+// it so settlements can be audited.
+type Conversion struct {
+	Original  Money
+	Converted Money
+	Rate      FXRate
+	Rounding  RoundingMode
+}
+
+// Convert expresses m in target currency using rate, rounding to the target
+// scale with the given mode. Converting to the same currency is an identity.
+func Convert(m Money, target Currency, rate FXRate, rounding RoundingMode) (Conversion, error) {
+	if m.currency == target {
+		return Conversion{
+			Original:  m,
+			Converted: m,
+			Rate:      FXRate{From: target, To: target, Rate: decimal.NewFromInt(1), EffectiveAt: rate.EffectiveAt, Source: rate.Source},
+			Rounding:  rounding,
+		}, nil
+	}
+	if err := rate.validate(); err != nil {
+		return Conversion{}, err
+	}
+	if rate.From != m.currency || rate.To != target {
+		return Conversion{}, ErrCurrencyMismatch
+	}
+
+	converted, err := NewRounded(m.amount.Mul(rate.Rate), target, rounding)
+	if err != nil {
+		return Conversion{}, err
+	}
+	return Conversion{Original: m, Converted: converted, Rate: rate, Rounding: rounding}, nil
+}
+
+type fxPair struct {
+	from Currency
+	to   Currency
+}
+
+// FXRateTable is an in-memory FXRateProvider holding effective-dated rates
+// per currency pair; the latest rate effective at or before the lookup wins.
+type FXRateTable struct {
+	mu    sync.RWMutex
+	rates map[fxPair][]FXRate
+}
+
+func NewFXRateTable(rates ...FXRate) (*FXRateTable, error) {
+	t := &FXRateTable{rates: make(map[fxPair][]FXRate)}
+	if err := t.Add(rates...); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Add inserts rates, keeping each pair ordered by effective time. A second
+// rate for the same pair and instant replaces the first.
+func (t *FXRateTable) Add(rates ...FXRate) error {
+	for _, r := range rates {
+		if err := r.validate(); err != nil {
+			return err
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, r := range rates {
+		key := fxPair{from: r.From, to: r.To}
+		series := t.rates[key]
+		idx := sort.Search(len(series), func(i int) bool {
+			return !series[i].EffectiveAt.Before(r.EffectiveAt)
+		})
+		if idx < len(series) && series[idx].EffectiveAt.Equal(r.EffectiveAt) {
+			series[idx] = r
+			continue
+		}
+		series = append(series, FXRate{})
+		copy(series[idx+1:], series[idx:])
+		series[idx] = r
+		t.rates[key] = series
+	}
+	return nil
+}
+
+func (t *FXRateTable) Rate(from, to Currency, at time.Time) (FXRate, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	series := t.rates[fxPair{from: from, to: to}]
+	idx := sort.Search(len(series), func(i int) bool {
+		return series[i].EffectiveAt.After(at)
+	})
+	if idx == 0 {
+		return FXRate{}, ErrFXRateNotFound
+	}
+	return series[idx-1], nil
+}
+
+var _ FXRateProvider = (*FXRateTable)(nil)
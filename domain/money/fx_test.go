@@ -0,0 +1,66 @@
+package money
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertRecordsRate(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	usd, _ := FromMinor(1_234, CurrencyUSD) // $12.34
+	rate := FXRate{From: CurrencyUSD, To: CurrencyKRW, Rate: decimal.RequireFromString("1350.55"), EffectiveAt: at, Source: "test"}
+
+	conv, err := Convert(usd, CurrencyKRW, rate, RoundHalfUp)
+	require.NoError(t, err)
+	require.Equal(t, "16666", conv.Converted.Amount().String()) // 16665.787
+	require.Equal(t, CurrencyKRW, conv.Converted.Currency())
+	require.Equal(t, rate, conv.Rate)
+	require.Equal(t, usd, conv.Original)
+
+	down, err := Convert(usd, CurrencyKRW, rate, RoundDown)
+	require.NoError(t, err)
+	require.Equal(t, "16665", down.Converted.Amount().String())
+}
+
+func TestConvertValidatesRate(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	krw, _ := FromMinor(10_000, CurrencyKRW)
+
+	_, err := Convert(krw, CurrencyUSD, FXRate{From: CurrencyUSD, To: CurrencyKRW, Rate: decimal.NewFromInt(1300), EffectiveAt: at}, RoundHalfUp)
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	_, err = Convert(krw, CurrencyUSD, FXRate{From: CurrencyKRW, To: CurrencyUSD, Rate: decimal.Zero, EffectiveAt: at}, RoundHalfUp)
+	require.ErrorIs(t, err, ErrInvalidFXRate)
+
+	same, err := Convert(krw, CurrencyKRW, FXRate{}, RoundHalfUp)
+	require.NoError(t, err)
+	require.Equal(t, krw, same.Converted)
+}
+
+func TestFXRateTablePicksLatestEffectiveRate(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	table, err := NewFXRateTable(
+		FXRate{From: CurrencyUSD, To: CurrencyKRW, Rate: decimal.NewFromInt(1350), EffectiveAt: feb},
+		FXRate{From: CurrencyUSD, To: CurrencyKRW, Rate: decimal.NewFromInt(1300), EffectiveAt: jan},
+	)
+	require.NoError(t, err)
+
+	_, err = table.Rate(CurrencyUSD, CurrencyKRW, jan.Add(-time.Second))
+	require.ErrorIs(t, err, ErrFXRateNotFound)
+
+	r, err := table.Rate(CurrencyUSD, CurrencyKRW, feb.Add(-time.Second))
+	require.NoError(t, err)
+	require.Equal(t, "1300", r.Rate.String())
+
+	r, err = table.Rate(CurrencyUSD, CurrencyKRW, feb)
+	require.NoError(t, err)
+	require.Equal(t, "1350", r.Rate.String())
+
+	_, err = table.Rate(CurrencyKRW, CurrencyUSD, feb)
+	require.ErrorIs(t, err, ErrFXRateNotFound)
+}
@@ -10,27 +10,22 @@ import (
 var (
 	ErrInvalidCurrency  = errors.New("invalid currency")
 	ErrCurrencyMismatch = errors.New("currency mismatch")
-)
-
-// Currency expects an ISO-like currency identifier.
-type Currency string
 
-const (
-	CurrencyKRW Currency = "KRW"
-	CurrencyUSD Currency = "USD"
+	ErrCurrencyAlreadyRegistered = errors.New("currency already registered")
+	ErrInvalidRoundingMode       = errors.New("invalid rounding mode")
+	ErrInvalidRatios             = errors.New("invalid allocation ratios")
+	ErrPrecisionExceeded         = errors.New("amount exceeds currency precision")
+	ErrInvalidMoneyText          = errors.New("invalid money text")
+	ErrInvalidFXRate             = errors.New("invalid fx rate")
+	ErrFXRateNotFound            = errors.New("fx rate not found")
+	ErrInvalidRate               = errors.New("invalid interest rate")
+	ErrUnsupportedLocale         = errors.New("unsupported locale")
+	ErrDivisionByZero            = errors.New("division by zero")
+	ErrEmptySum                  = errors.New("sum of no amounts")
+	ErrNegativeAmount            = errors.New("negative amount not allowed")
+	ErrInvalidDayCount           = errors.New("invalid day count convention")
 )
 
-func currencyScale(c Currency) (int32, error) {
-	switch c {
-	case CurrencyKRW:
-		return 0, nil
-	case CurrencyUSD:
-		return 2, nil
-	default:
-		return 0, ErrInvalidCurrency
-	}
-}
-
 // Money represents an amount normalized to the configured currency scale.
 type Money struct {
 	amount   decimal.Decimal
@@ -40,12 +35,20 @@ type Money struct {
 
 // New는 금액을 통화 스케일에 맞춰 반올림하여 Money를 생성합니다.
 func New(amount decimal.Decimal, currency Currency) (Money, error) {
+	return NewRounded(amount, currency, RoundHalfUp)
+}
+
+// NewRounded는 지정한 반올림 모드로 통화 스케일에 맞춰 Money를 생성합니다.
+func NewRounded(amount decimal.Decimal, currency Currency, mode RoundingMode) (Money, error) {
+	if !mode.IsValid() {
+		return Money{}, ErrInvalidRoundingMode
+	}
 	scale, err := currencyScale(currency)
 	if err != nil {
 		return Money{}, err
 	}
 	return Money{
-		amount:   amount.Round(scale),
+		amount:   mode.Round(amount, scale),
 		currency: currency,
 		scale:    scale,
 	}, nil
@@ -86,6 +89,11 @@ func (m Money) Amount() decimal.Decimal {
 	return m.amount
 }
 
+// Scale is the number of minor-unit digits of the currency.
+func (m Money) Scale() int32 {
+	return m.scale
+}
+
 func (m Money) IsZero() bool {
 	return m.amount.IsZero()
 }
@@ -109,12 +117,16 @@ func (m Money) Sub(other Money) (Money, error) {
 // MulBPS는 basis points(만분율)로 배수를 적용합니다.
 // 100bps = 1%, 10_000bps = 100%. 반올림은 통화 스케일에서 half-up.
 func (m Money) MulBPS(bps int64) Money {
+	return m.MulBPSRounded(bps, RoundHalfUp)
+}
+
+// MulBPSRounded는 지정한 반올림 모드로 basis points 배수를 적용합니다.
+// 알 수 없는 모드는 half-up으로 처리합니다.
+func (m Money) MulBPSRounded(bps int64, mode RoundingMode) Money {
 	if bps == 0 || m.amount.IsZero() {
 		return Money{currency: m.currency, scale: m.scale}
 	}
-	rate := decimal.NewFromInt(bps).Div(decimal.NewFromInt(10_000))
-	delta := m.amount.Mul(rate).Round(m.scale)
-	return Money{amount: delta, currency: m.currency, scale: m.scale}
+	return m.MulRateRounded(RateFromBPS(bps), mode)
 }
 
 // ApplyBPS는 원금에 bps 이율을 적용한 금액을 반환합니다.
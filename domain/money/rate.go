@@ -0,0 +1,99 @@
+package money
+
+import "github.com/shopspring/decimal"
+
+// rateDivPrecision bounds non-terminating divisions such as 0.2/365.
+const rateDivPrecision = 16
+
+var (
+	bpsPerUnit     = decimal.NewFromInt(10_000)
+	percentPerUnit = decimal.NewFromInt(100)
+)
+
+// Rate is an interest rate per period stored as an exact decimal fraction
+// (0.0005 = 5 bps), so rates that are not whole basis points such as a 20% APR
+// spread over 365 days keep their precision until the money is rounded.
+type Rate struct {
+	value decimal.Decimal
+}
+
+// NewRate wraps a fraction per period and rejects negative rates with
+// ErrInvalidRate. Use it for rates read from storage, feeds or configuration.
+func NewRate(fraction decimal.Decimal) (Rate, error) {
+	r := Rate{value: fraction}
+	if err := r.Validate(); err != nil {
+		return Rate{}, err
+	}
+	return r, nil
+}
+
+// RateFromBPS converts whole basis points to a rate without validation.
+func RateFromBPS(bps int64) Rate {
+	return Rate{value: decimal.NewFromInt(bps).Div(bpsPerUnit)}
+}
+
+// RateFromFraction wraps a fraction per period (0.0005 = 0.05%) without
+// validation; see NewRate.
+func RateFromFraction(fraction decimal.Decimal) Rate {
+	return Rate{value: fraction}
+}
+
+// DailyRateFromAPR converts an annual percentage rate (20 = 20%) into a simple
+// daily rate over daysInYear days without rounding the result.
+func DailyRateFromAPR(aprPercent decimal.Decimal, daysInYear int) (Rate, error) {
+	if daysInYear <= 0 || aprPercent.IsNegative() {
+		return Rate{}, ErrInvalidRate
+	}
+	return Rate{value: aprPercent.Div(percentPerUnit).DivRound(decimal.NewFromInt(int64(daysInYear)), rateDivPrecision)}, nil
+}
+
+// Fraction returns the rate as a fraction per period.
+func (r Rate) Fraction() decimal.Decimal {
+	return r.value
+}
+
+// BPS returns the rate in (possibly fractional) basis points.
+func (r Rate) BPS() decimal.Decimal {
+	return r.value.Mul(bpsPerUnit)
+}
+
+func (r Rate) IsZero() bool {
+	return r.value.IsZero()
+}
+
+// Validate reports ErrInvalidRate for a negative rate, the single rule every
+// interest rate must satisfy.
+func (r Rate) Validate() error {
+	if r.value.IsNegative() {
+		return ErrInvalidRate
+	}
+	return nil
+}
+
+func (r Rate) Equal(other Rate) bool {
+	return r.value.Equal(other.value)
+}
+
+// String renders the rate in basis points, e.g. "5.4794520547945205bps".
+func (r Rate) String() string {
+	return r.BPS().String() + "bps"
+}
+
+// MulRate applies the rate to the amount with half-up rounding at currency scale.
+func (m Money) MulRate(r Rate) Money {
+	return m.MulRateRounded(r, RoundHalfUp)
+}
+
+// MulRateRounded applies the rate and rounds once with the given mode.
+func (m Money) MulRateRounded(r Rate, mode RoundingMode) Money {
+	return Money{
+		amount:   mode.Round(m.amount.Mul(r.value), m.scale),
+		currency: m.currency,
+		scale:    m.scale,
+	}
+}
+
+// ApplyRate adds the rate delta to the amount.
+func (m Money) ApplyRate(r Rate) (Money, error) {
+	return m.Add(m.MulRate(r))
+}
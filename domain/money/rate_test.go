@@ -0,0 +1,68 @@
+package money
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateConstructorsAgree(t *testing.T) {
+	require.True(t, RateFromBPS(125).Equal(RateFromFraction(decimal.RequireFromString("0.0125"))))
+	require.Equal(t, "125bps", RateFromBPS(125).String())
+}
+
+func TestDailyRateFromAPRKeepsFractionalBPS(t *testing.T) {
+	r, err := DailyRateFromAPR(decimal.NewFromInt(20), 365)
+	require.NoError(t, err)
+	require.Equal(t, "5.479", r.BPS().StringFixed(3))
+	require.False(t, r.Equal(RateFromBPS(5)))
+
+	_, err = DailyRateFromAPR(decimal.NewFromInt(20), 0)
+	require.ErrorIs(t, err, ErrInvalidRate)
+	_, err = DailyRateFromAPR(decimal.NewFromInt(-1), 365)
+	require.ErrorIs(t, err, ErrInvalidRate)
+}
+
+func TestNewRateRejectsNegative(t *testing.T) {
+	r, err := NewRate(decimal.RequireFromString("0.0005"))
+	require.NoError(t, err)
+	require.True(t, r.Equal(RateFromBPS(5)))
+
+	_, err = NewRate(decimal.RequireFromString("-0.0005"))
+	require.ErrorIs(t, err, ErrInvalidRate)
+	require.ErrorIs(t, RateFromBPS(-1).Validate(), ErrInvalidRate)
+	require.NoError(t, Rate{}.Validate())
+}
+
+func TestMulRateRoundsOnlyTheResult(t *testing.T) {
+	m, _ := FromMinor(1_000_000, CurrencyKRW)
+	r, err := DailyRateFromAPR(decimal.NewFromInt(20), 365)
+	require.NoError(t, err)
+
+	require.Equal(t, decimal.NewFromInt(548), m.MulRate(r).Amount()) // 547.945...
+	require.Equal(t, decimal.NewFromInt(500), m.MulBPS(5).Amount())  // rate rounded first
+	require.Equal(t, decimal.NewFromInt(547), m.MulRateRounded(r, RoundDown).Amount())
+	require.Equal(t, m.MulBPS(125), m.MulRate(RateFromBPS(125)))
+}
+
+func TestDayCount_DailyRate(t *testing.T) {
+	leap := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	apr := decimal.NewFromInt(20)
+
+	for dc, days := range map[DayCount]int{"": 365, DayCountACT365: 365, DayCountACT360: 360, DayCountACTACT: 366} {
+		got, err := dc.DailyRate(apr, leap)
+		require.NoError(t, err)
+		want, err := DailyRateFromAPR(apr, days)
+		require.NoError(t, err)
+		require.True(t, got.Equal(want), dc)
+	}
+
+	days, err := DayCountACTACT.DaysInYear(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, 365, days)
+
+	_, err = DayCount("30/360").DailyRate(apr, leap)
+	require.ErrorIs(t, err, ErrInvalidDayCount)
+}
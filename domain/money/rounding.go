@@ -0,0 +1,119 @@
+package money
+
+import "github.com/shopspring/decimal"
+
+// RoundingMode decides how amounts are brought to currency scale.
+type RoundingMode string
+
+const (
+	// RoundHalfUp rounds halves away from zero; it is the default.
+	RoundHalfUp RoundingMode = "HALF_UP"
+	// RoundHalfEven rounds halves to the nearest even digit (banker's rounding).
+	RoundHalfEven RoundingMode = "HALF_EVEN"
+	// RoundDown truncates toward zero.
+	RoundDown RoundingMode = "DOWN"
+	// RoundUp rounds away from zero.
+	RoundUp RoundingMode = "UP"
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling RoundingMode = "CEILING"
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor RoundingMode = "FLOOR"
+)
+
+// IsValid reports whether the mode is known; the empty mode means RoundHalfUp.
+func (r RoundingMode) IsValid() bool {
+	switch r {
+	case "", RoundHalfUp, RoundHalfEven, RoundDown, RoundUp, RoundCeiling, RoundFloor:
+		return true
+	default:
+		return false
+	}
+}
+
+// Round applies the mode at the given scale.
+func (r RoundingMode) Round(d decimal.Decimal, scale int32) decimal.Decimal {
+	switch r {
+	case RoundHalfEven:
+		return d.RoundBank(scale)
+	case RoundDown:
+		return d.RoundDown(scale)
+	case RoundUp:
+		return d.RoundUp(scale)
+	case RoundCeiling:
+		return d.RoundCeil(scale)
+	case RoundFloor:
+		return d.RoundFloor(scale)
+	default:
+		return d.Round(scale)
+	}
+}
+
+// Context bundles rounding policy for a series of Money operations, e.g. one
+// configured per product or market. With NonNegative set every result below
+// zero is rejected with ErrNegativeAmount, which suits customer-facing balances.
+type Context struct {
+	Rounding    RoundingMode
+	NonNegative bool
+}
+
+// New builds Money using the context's rounding mode.
+func (c Context) New(amount decimal.Decimal, currency Currency) (Money, error) {
+	return c.check(NewRounded(amount, currency, c.Rounding))
+}
+
+// Add adds two amounts under the context's sign policy.
+func (c Context) Add(a, b Money) (Money, error) {
+	return c.check(a.Add(b))
+}
+
+// Sub subtracts b from a under the context's sign policy.
+func (c Context) Sub(a, b Money) (Money, error) {
+	return c.check(a.Sub(b))
+}
+
+// Sum adds the amounts under the context's sign policy.
+func (c Context) Sum(ms ...Money) (Money, error) {
+	return c.check(Sum(ms...))
+}
+
+// Mul multiplies using the context's rounding mode and sign policy.
+func (c Context) Mul(m Money, factor decimal.Decimal) (Money, error) {
+	return c.check(m.MulRounded(factor, c.Rounding))
+}
+
+// Div divides using the context's rounding mode and sign policy.
+func (c Context) Div(m Money, divisor decimal.Decimal) (Money, error) {
+	return c.check(m.Div(divisor, c.Rounding))
+}
+
+// MulBPS applies basis points using the context's rounding mode and sign policy.
+func (c Context) MulBPS(m Money, bps int64) (Money, error) {
+	return c.MulRate(m, RateFromBPS(bps))
+}
+
+// ApplyBPS adds the basis-point delta using the context's rounding mode. Only
+// the result is held to the sign policy, so a negative delta may reduce m.
+func (c Context) ApplyBPS(m Money, bps int64) (Money, error) {
+	if !c.Rounding.IsValid() {
+		return Money{}, ErrInvalidRoundingMode
+	}
+	return c.check(m.Add(m.MulBPSRounded(bps, c.Rounding)))
+}
+
+// MulRate applies a rate using the context's rounding mode and sign policy.
+func (c Context) MulRate(m Money, r Rate) (Money, error) {
+	if !c.Rounding.IsValid() {
+		return Money{}, ErrInvalidRoundingMode
+	}
+	return c.check(m.MulRateRounded(r, c.Rounding), nil)
+}
+
+func (c Context) check(m Money, err error) (Money, error) {
+	if err != nil {
+		return Money{}, err
+	}
+	if c.NonNegative && m.IsNegative() {
+		return Money{}, ErrNegativeAmount
+	}
+	return m, nil
+}
@@ -0,0 +1,56 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundingModes(t *testing.T) {
+	cases := []struct {
+		mode RoundingMode
+		in   string
+		want string
+	}{
+		{RoundHalfUp, "12.5", "13"},
+		{RoundHalfUp, "-12.5", "-13"},
+		{RoundHalfEven, "12.5", "12"},
+		{RoundHalfEven, "13.5", "14"},
+		{RoundDown, "12.9", "12"},
+		{RoundDown, "-12.9", "-12"},
+		{RoundUp, "12.1", "13"},
+		{RoundUp, "-12.1", "-13"},
+		{RoundCeiling, "-12.9", "-12"},
+		{RoundFloor, "12.9", "12"},
+		{RoundFloor, "-12.1", "-13"},
+	}
+	for _, tc := range cases {
+		m, err := NewRounded(decimal.RequireFromString(tc.in), CurrencyKRW, tc.mode)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, m.Amount().String(), "%s %s", tc.mode, tc.in)
+	}
+
+	_, err := NewRounded(decimal.NewFromInt(1), CurrencyKRW, "SIDEWAYS")
+	require.ErrorIs(t, err, ErrInvalidRoundingMode)
+}
+
+func TestMulBPSRoundedPerOperation(t *testing.T) {
+	m, _ := New(decimal.NewFromInt(1000), CurrencyKRW)
+
+	require.Equal(t, decimal.NewFromInt(13), m.MulBPSRounded(125, RoundHalfUp).Amount())
+	require.Equal(t, decimal.NewFromInt(12), m.MulBPSRounded(125, RoundHalfEven).Amount())
+	require.Equal(t, decimal.NewFromInt(12), m.MulBPSRounded(125, RoundDown).Amount())
+}
+
+func TestContextAppliesRounding(t *testing.T) {
+	ctx := Context{Rounding: RoundDown}
+
+	m, err := ctx.New(decimal.RequireFromString("10.999"), CurrencyUSD)
+	require.NoError(t, err)
+	require.Equal(t, "10.99", m.Amount().StringFixed(2))
+
+	withRate, err := ctx.ApplyBPS(m, 125) // 0.137375 -> 0.13
+	require.NoError(t, err)
+	require.Equal(t, "11.12", withRate.Amount().StringFixed(2))
+}
@@ -0,0 +1,42 @@
+package payment
+
+import "github.com/jaeyoung0509/compound-interest/domain/money"
+
+// EarlyPaymentPolicy decides how a payment settled before its due date is treated.
+type EarlyPaymentPolicy interface {
+	// Discount returns the amount waived for paying daysEarly (> 0) days early,
+	// rounded with the payment product's rounding mode.
+	Discount(amount money.Money, daysEarly int, rounding money.RoundingMode) (money.Money, error)
+}
+
+// RejectEarlyPayment keeps the strict behaviour: paying before the due date fails.
+type RejectEarlyPayment struct{}
+
+func (RejectEarlyPayment) Discount(money.Money, int, money.RoundingMode) (money.Money, error) {
+	return money.Money{}, ErrPaidBeforeDueDate
+}
+
+// FaceValueEarlyPayment accepts early payments without a discount. It is the
+// default policy of Pay.
+type FaceValueEarlyPayment struct{}
+
+func (FaceValueEarlyPayment) Discount(amount money.Money, _ int, _ money.RoundingMode) (money.Money, error) {
+	return money.Zero(amount.Currency())
+}
+
+// DiscountEarlyPayment waives BPS of the amount when paying more than
+// MinDaysEarly days before the due date; otherwise it accepts face value.
+type DiscountEarlyPayment struct {
+	BPS          int64
+	MinDaysEarly int
+}
+
+func (d DiscountEarlyPayment) Discount(amount money.Money, daysEarly int, rounding money.RoundingMode) (money.Money, error) {
+	if d.BPS < 0 || d.BPS > 10_000 {
+		return money.Money{}, ErrInvalidDiscount
+	}
+	if daysEarly <= d.MinDaysEarly {
+		return money.Zero(amount.Currency())
+	}
+	return amount.MulBPSRounded(d.BPS, rounding), nil
+}
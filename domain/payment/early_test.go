@@ -0,0 +1,118 @@
+package payment
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayWith_FaceValueAcceptsEarlyPayment(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := New(uid, amt, base.AddDate(0, 0, 10), base)
+	require.NoError(t, err)
+
+	require.NoError(t, p.PayWith(base, FaceValueEarlyPayment{}))
+	require.Equal(t, StatusPaid, p.Status())
+	require.True(t, p.Discount().IsZero())
+}
+
+func TestPayWith_DiscountAppliesBeyondThreshold(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+	policy := DiscountEarlyPayment{BPS: 150, MinDaysEarly: 7}
+
+	p, err := New(uid, amt, base.AddDate(0, 0, 10), base)
+	require.NoError(t, err)
+	require.NoError(t, p.PayWith(base, policy)) // 10 days early
+
+	require.True(t, p.Discount().Amount().Equal(mustKRW(t, 150).Amount()))
+
+	events := p.PullEvents()
+	require.Len(t, events, 1)
+	paid, ok := events[0].(PaymentPaid)
+	require.True(t, ok)
+	require.True(t, paid.Discount.Amount().Equal(mustKRW(t, 150).Amount()))
+	require.Equal(t, money.CurrencyKRW, paid.Discount.Currency())
+}
+
+func TestPayWith_DiscountUsesProductRounding(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt, err := money.FromMinor(1_010, money.CurrencyUSD) // $10.10
+	require.NoError(t, err)
+	policy := DiscountEarlyPayment{BPS: 125} // $0.12625
+
+	halfUp, err := New(uid, amt, base.AddDate(0, 0, 10), base)
+	require.NoError(t, err)
+	require.NoError(t, halfUp.PayWith(base, policy))
+	require.Equal(t, "0.13", halfUp.Discount().Amount().StringFixed(2))
+
+	down, err := New(uid, amt, base.AddDate(0, 0, 10), base,
+		WithProduct(Product{Code: "BNPL_DOWN", Rounding: money.RoundDown}))
+	require.NoError(t, err)
+	require.NoError(t, down.PayWith(base, policy))
+	require.Equal(t, "0.12", down.Discount().Amount().StringFixed(2))
+}
+
+func TestPayWith_DiscountSkippedWithinThreshold(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+	policy := DiscountEarlyPayment{BPS: 150, MinDaysEarly: 7}
+
+	p, err := New(uid, amt, base.AddDate(0, 0, 7), base)
+	require.NoError(t, err)
+	require.NoError(t, p.PayWith(base, policy)) // exactly 7 days early
+
+	require.Equal(t, StatusPaid, p.Status())
+	require.True(t, p.Discount().IsZero())
+}
+
+func TestPayWith_InvalidDiscountRejected(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := New(uid, amt, base.AddDate(0, 0, 10), base)
+	require.NoError(t, err)
+
+	err = p.PayWith(base, DiscountEarlyPayment{BPS: 20_000})
+	require.ErrorIs(t, err, ErrInvalidDiscount)
+	require.Equal(t, StatusScheduled, p.Status())
+}
+
+func TestPayWith_OnOrAfterDueIgnoresPolicy(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := New(uid, amt, base, base)
+	require.NoError(t, err)
+
+	require.NoError(t, p.PayWith(base.Add(3*time.Hour), RejectEarlyPayment{}))
+	require.True(t, p.Discount().IsZero())
+}
+
+func TestPaymentPaid_JSONCarriesDiscountAsMoney(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := New(uid, amt, base.AddDate(0, 0, 10), base)
+	require.NoError(t, err)
+	require.NoError(t, p.PayWith(base, DiscountEarlyPayment{BPS: 150}))
+
+	data, err := json.Marshal(p.PullEvents()[0])
+	require.NoError(t, err)
+
+	var payload map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(data, &payload))
+	require.JSONEq(t, `{"amount":"150","currency":"KRW"}`, string(payload["discount"]))
+}
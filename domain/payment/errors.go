@@ -15,4 +15,10 @@ var (
 	ErrPaidPaymentCannotOverdue = errors.New("paid payment cannot be marked overdue")
 	ErrInvalidOverdueArgs       = errors.New("invalid overdue args")
 	ErrOverduePeriodTooLong     = errors.New("overdue period exceeds limit")
+	ErrInvalidPaymentID         = errors.New("invalid payment id")
+	ErrInvalidStatus            = errors.New("invalid payment status")
+	ErrConcurrentModification   = errors.New("payment modified concurrently")
+	ErrInvalidDiscount          = errors.New("invalid early payment discount")
+	ErrInvalidProduct           = errors.New("invalid product")
+	ErrNoRateAvailable          = errors.New("no rate provider could supply a rate")
 )
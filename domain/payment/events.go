@@ -3,6 +3,7 @@ package payment
 import (
 	"time"
 
+	"github.com/jaeyoung0509/compound-interest/domain/money"
 	"github.com/jaeyoung0509/compound-interest/domain/shared"
 )
 
@@ -12,13 +13,12 @@ const (
 )
 
 type OverdueAccrued struct {
-	PaymentID       string    `json:"payment_id"`
-	UserID          string    `json:"user_id"`
-	DaysOverdue     int       `json:"days_overdue"`
-	PenaltyAmount   string    `json:"penalty_amount"`
-	PenaltyCurrency string    `json:"penalty_currency"`
-	CalculatedAt    time.Time `json:"calculated_at"`
-	OccurredAtTime  time.Time `json:"occurred_at"`
+	PaymentID      string      `json:"payment_id"`
+	UserID         string      `json:"user_id"`
+	DaysOverdue    int         `json:"days_overdue"`
+	Penalty        money.Money `json:"penalty"`
+	CalculatedAt   time.Time   `json:"calculated_at"`
+	OccurredAtTime time.Time   `json:"occurred_at"`
 }
 
 func (e OverdueAccrued) EventType() string {
@@ -40,10 +40,11 @@ func (e OverdueAccrued) OccurredAt() time.Time {
 var _ shared.DomainEvent = OverdueAccrued{}
 
 type PaymentPaid struct {
-	PaymentID      string    `json:"payment_id"`
-	UserID         string    `json:"user_id"`
-	PaidAt         time.Time `json:"paid_at"`
-	OccurredAtTime time.Time `json:"occurred_at"`
+	PaymentID      string      `json:"payment_id"`
+	UserID         string      `json:"user_id"`
+	PaidAt         time.Time   `json:"paid_at"`
+	Discount       money.Money `json:"discount"`
+	OccurredAtTime time.Time   `json:"occurred_at"`
 }
 
 func (e PaymentPaid) EventType() string {
@@ -65,23 +66,22 @@ func (e PaymentPaid) OccurredAt() time.Time {
 var _ shared.DomainEvent = PaymentPaid{}
 
 func newOverdueAccruedEvent(p *Payment, calculatedAt, occurredAt time.Time) OverdueAccrued {
-	penalty := p.overdue.Penalty
 	return OverdueAccrued{
-		PaymentID:       p.id.String(),
-		UserID:          p.userID.Value().String(),
-		DaysOverdue:     p.overdue.DaysOverdue,
-		PenaltyAmount:   penalty.Amount().String(),
-		PenaltyCurrency: string(penalty.Currency()),
-		CalculatedAt:    calculatedAt,
-		OccurredAtTime:  occurredAt,
+		PaymentID:      p.id.String(),
+		UserID:         p.userID.String(),
+		DaysOverdue:    p.overdue.DaysOverdue,
+		Penalty:        p.overdue.Penalty,
+		CalculatedAt:   calculatedAt,
+		OccurredAtTime: occurredAt,
 	}
 }
 
 func newPaymentPaidEvent(p *Payment, paidAt time.Time) PaymentPaid {
 	return PaymentPaid{
 		PaymentID:      p.id.String(),
-		UserID:         p.userID.Value().String(),
+		UserID:         p.userID.String(),
 		PaidAt:         paidAt,
+		Discount:       p.discount,
 		OccurredAtTime: paidAt,
 	}
 }
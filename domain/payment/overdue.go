@@ -5,12 +5,16 @@ import (
 
 	"github.com/jaeyoung0509/compound-interest/domain/money"
 	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/shopspring/decimal"
 )
 
 type OverdueInfo struct {
-	ID           shared.ID
-	IsOverdue    bool
-	DaysOverdue  int
-	Penalty      money.Money
+	ID          shared.ID
+	IsOverdue   bool
+	DaysOverdue int
+	Penalty     money.Money
+	// Carry is accrued interest not yet posted to Penalty because it is below
+	// the currency's minor unit; Penalty+Carry is the exact compounded penalty.
+	Carry        decimal.Decimal
 	CalculatedAt time.Time
 }
@@ -6,28 +6,38 @@ import (
 	"github.com/jaeyoung0509/compound-interest/domain/money"
 	"github.com/jaeyoung0509/compound-interest/domain/shared"
 	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/shopspring/decimal"
 )
 
 // Payment is the aggregate root that encapsulates payment lifecycle transitions.
 type Payment struct {
-	id        shared.ID
-	userID    user.ID
-	amount    money.Money
-	dueDate   time.Time
-	paidAt    *time.Time
-	status    Status
-	overdue   *OverdueInfo
-	createdAt time.Time
-	updatedAt time.Time
+	id       shared.ID
+	userID   user.ID
+	amount   money.Money
+	product  Product
+	dueDate  time.Time
+	paidAt   *time.Time
+	discount money.Money
+	status   Status
+	overdue  *OverdueInfo
+	pending  []AccrualLine
+	// lockedRate, when set, prices every accrual regardless of the provider.
+	lockedRate *money.Rate
+	lockFrom   DailyRateProvider
+	ids        shared.IDGenerator
+	events     []shared.DomainEvent
+	createdAt  time.Time
+	updatedAt  time.Time
+	version    int64
 }
 
 const maxOverdueDays = 365*3 + 1 // three years with a leap-day allowance
 
-func New(userID user.ID, amount money.Money, dueDate time.Time, now time.Time) (*Payment, error) {
+func New(userID user.ID, amount money.Money, dueDate time.Time, now time.Time, opts ...Option) (*Payment, error) {
 	if userID.IsZero() {
 		return nil, ErrInvalidUserID
 	}
-	if amount.Amount().Sign() <= 0 {
+	if !amount.IsPositive() {
 		return nil, ErrInvalidAmount
 	}
 	if dueDate.IsZero() {
@@ -40,16 +50,132 @@ func New(userID user.ID, amount money.Money, dueDate time.Time, now time.Time) (
 	if truncateToDate(now).After(dueDate) {
 		return nil, ErrDueDateInPast
 	}
+	discount, err := money.Zero(amount.Currency())
+	if err != nil {
+		return nil, err
+	}
 
-	return &Payment{
-		id:        shared.NewID(),
+	p := &Payment{
 		userID:    userID,
 		amount:    amount,
+		product:   DefaultProduct,
 		dueDate:   dueDate,
+		discount:  discount,
 		status:    StatusScheduled,
+		ids:       shared.DefaultIDGenerator(),
 		createdAt: now,
 		updatedAt: now,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if err := p.product.validate(); err != nil {
+		return nil, err
+	}
+	if p.lockFrom != nil {
+		rate, err := dailyRateAt(p.lockFrom, now)
+		if err != nil {
+			return nil, err
+		}
+		p.lockedRate = &rate
+		p.lockFrom = nil
+	}
+	p.id = p.ids.NewID()
+	return p, nil
+}
+
+// Snapshot carries persisted state used to rebuild a Payment.
+type Snapshot struct {
+	ID       shared.ID
+	UserID   user.ID
+	Amount   money.Money
+	Product  Product
+	DueDate  time.Time
+	PaidAt   *time.Time
+	Discount money.Money
+	Status   Status
+	Overdue  *OverdueInfo
+	// LockedRate is the daily rate fixed at origination, nil for floating payments.
+	LockedRate *money.Rate
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Version    int64
+}
+
+// Reconstitute rebuilds a Payment from storage without replaying transitions.
+// Options apply on top of the snapshot, e.g. WithIDGenerator for the overdue
+// snapshots issued by later accruals. WithLockedRate is ignored: a rate is
+// locked only at origination, and s.LockedRate restores it.
+func Reconstitute(s Snapshot, opts ...Option) (*Payment, error) {
+	if shared.IsZero(s.ID) {
+		return nil, ErrInvalidPaymentID
+	}
+	if s.UserID.IsZero() {
+		return nil, ErrInvalidUserID
+	}
+	if !s.Amount.IsPositive() {
+		return nil, ErrInvalidAmount
+	}
+	if s.DueDate.IsZero() {
+		return nil, ErrInvalidDueDate
+	}
+	if !s.Status.IsValid() {
+		return nil, ErrInvalidStatus
+	}
+	if s.Status == StatusPaid && s.PaidAt == nil {
+		return nil, ErrInvalidPaidAt
+	}
+	product := s.Product
+	if product.Code == "" {
+		product = DefaultProduct
+	}
+	if err := product.validate(); err != nil {
+		return nil, err
+	}
+	discount := s.Discount
+	if discount.Currency() == "" {
+		var err error
+		if discount, err = money.Zero(s.Amount.Currency()); err != nil {
+			return nil, err
+		}
+	}
+	if discount.Currency() != s.Amount.Currency() {
+		return nil, ErrInvalidDiscount
+	}
+
+	p := &Payment{
+		id:        s.ID,
+		userID:    s.UserID,
+		amount:    s.Amount,
+		product:   product,
+		dueDate:   truncateToDate(s.DueDate),
+		discount:  discount,
+		status:    s.Status,
+		ids:       shared.DefaultIDGenerator(),
+		createdAt: s.CreatedAt,
+		updatedAt: s.UpdatedAt,
+		version:   s.Version,
+	}
+	if s.PaidAt != nil {
+		paidAt := *s.PaidAt
+		p.paidAt = &paidAt
+	}
+	if s.Overdue != nil {
+		info := *s.Overdue
+		p.overdue = &info
+	}
+	if s.LockedRate != nil {
+		rate := *s.LockedRate
+		p.lockedRate = &rate
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	p.lockFrom = nil
+	if err := p.product.validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
 func (p *Payment) ID() shared.ID {
@@ -64,10 +190,20 @@ func (p *Payment) Amount() money.Money {
 	return p.amount
 }
 
+func (p *Payment) Product() Product {
+	return p.product
+}
+
 func (p *Payment) DueDate() time.Time {
 	return p.dueDate
 }
 
+// DaysPastDue counts whole UTC days between the due date and asOf; zero when
+// asOf is on or before the due date.
+func (p *Payment) DaysPastDue(asOf time.Time) int {
+	return daysBetween(p.dueDate, asOf)
+}
+
 func (p *Payment) PaidAt() *time.Time {
 	if p.paidAt == nil {
 		return nil
@@ -76,6 +212,19 @@ func (p *Payment) PaidAt() *time.Time {
 	return &t
 }
 
+// Discount is the amount waived by the early payment policy when paid.
+func (p *Payment) Discount() money.Money {
+	return p.discount
+}
+
+// LockedRate returns the daily rate fixed at origination, if any.
+func (p *Payment) LockedRate() (money.Rate, bool) {
+	if p.lockedRate == nil {
+		return money.Rate{}, false
+	}
+	return *p.lockedRate, true
+}
+
 func (p *Payment) Status() Status {
 	return p.status
 }
@@ -96,20 +245,49 @@ func (p *Payment) UpdatedAt() time.Time {
 	return p.updatedAt
 }
 
+// Version is the persisted revision the aggregate was loaded at; zero means never saved.
+func (p *Payment) Version() int64 {
+	return p.version
+}
+
+// IncrementVersion is called by repositories once a save succeeded.
+func (p *Payment) IncrementVersion() {
+	p.version++
+}
+
+// Pay settles the payment, accepting early payments at face value.
 func (p *Payment) Pay(paidAt time.Time) error {
+	return p.PayWith(paidAt, FaceValueEarlyPayment{})
+}
+
+// PayWith settles the payment and lets the policy decide whether an early
+// payment is accepted and what discount applies.
+func (p *Payment) PayWith(paidAt time.Time, policy EarlyPaymentPolicy) error {
 	if paidAt.IsZero() {
 		return ErrInvalidPaidAt
 	}
 	if p.status == StatusPaid {
 		return ErrPaymentAlreadyPaid
 	}
-	if truncateToDate(paidAt).Before(truncateToDate(p.dueDate)) {
-		return ErrPaidBeforeDueDate
+
+	discount, err := money.Zero(p.amount.Currency())
+	if err != nil {
+		return err
+	}
+	if daysEarly := daysBetween(paidAt, p.dueDate); daysEarly > 0 {
+		if discount, err = policy.Discount(p.amount, daysEarly, p.product.Rounding); err != nil {
+			return err
+		}
+		if tooLarge, err := discount.GreaterThan(p.amount); err != nil || tooLarge || discount.IsNegative() {
+			return ErrInvalidDiscount
+		}
 	}
 
 	p.paidAt = &paidAt
+	p.discount = discount
 	p.status = StatusPaid
 	p.updatedAt = paidAt
+	p.events = append(p.events, newPaymentPaidEvent(p, paidAt))
 	return nil
 }
 
@@ -125,7 +303,7 @@ func (p *Payment) MarkOverdue(calculatedAt time.Time, daysOverdue int, penalty m
 	}
 
 	p.overdue = &OverdueInfo{
-		ID:           shared.NewID(),
+		ID:           p.ids.NewID(),
 		IsOverdue:    true,
 		DaysOverdue:  daysOverdue,
 		Penalty:      penalty,
@@ -137,18 +315,31 @@ func (p *Payment) MarkOverdue(calculatedAt time.Time, daysOverdue int, penalty m
 }
 
 // AccrueInterestWith pulls time and rate from collaborators to simplify wiring.
+// The provider is asked for each accrued day's rate, so a rate change inside
+// the window applies from the day it takes effect. Payments with a locked rate
+// never consult the provider.
 func (p *Payment) AccrueInterestWith(clock Clock, rateProvider DailyRateProvider) error {
-	now := clock.Now()
-	rate, err := rateProvider.DailyRateBPS(now)
-	if err != nil {
-		return err
-	}
-	return p.AccrueInterest(now, rate)
+	return p.AccrueInterestResolved(clock.Now(), providerRates{provider: rateProvider})
 }
 
 // AccrueInterest compounds daily interest from the due date (or last accrual)
-// up to the provided time using basis points per day. No-op if not past due.
+// up to the provided time using basis points per day, rounding each day's
+// delta with the product's rounding mode. No-op if not past due. Payments with
+// a locked rate accrue at it and ignore dailyRateBPS.
 func (p *Payment) AccrueInterest(now time.Time, dailyRateBPS int64) error {
+	return p.AccrueInterestRate(now, money.RateFromBPS(dailyRateBPS))
+}
+
+// AccrueInterestRate is AccrueInterest with a high-precision daily rate; a
+// locked rate likewise takes precedence over dailyRate.
+func (p *Payment) AccrueInterestRate(now time.Time, dailyRate money.Rate) error {
+	return p.AccrueInterestResolved(now, flatRate{rate: dailyRate})
+}
+
+// AccrueInterestResolved asks the resolver for each day's rate, passing the
+// payment context and the day's overdue count. Payments with a locked rate
+// always accrue at it and ignore the resolver.
+func (p *Payment) AccrueInterestResolved(now time.Time, resolver RateResolver) error {
 	if now.IsZero() {
 		return ErrInvalidOverdueArgs
 	}
@@ -156,55 +347,151 @@ func (p *Payment) AccrueInterest(now time.Time, dailyRateBPS int64) error {
 		return ErrPaidPaymentCannotOverdue
 	}
 
+	res, err := p.project(now, resolver)
+	if err != nil {
+		return err
+	}
+	if len(res.lines) == 0 {
+		return nil
+	}
+
+	p.overdue = &OverdueInfo{
+		ID:           p.ids.NewID(),
+		IsOverdue:    true,
+		DaysOverdue:  res.daysOverdue,
+		Penalty:      res.penalty,
+		Carry:        res.carry,
+		CalculatedAt: res.calculatedAt,
+	}
+	p.pending = append(p.pending, res.lines...)
+	p.status = StatusOverdue
+	p.updatedAt = now
+	p.events = append(p.events, newOverdueAccruedEvent(p, res.calculatedAt, now))
+	return nil
+}
+
+// PullEvents returns the domain events raised since the last call and clears them.
+func (p *Payment) PullEvents() []shared.DomainEvent {
+	events := p.events
+	p.events = nil
+	return events
+}
+
+// PullAccrualLines returns the ledger lines accrued since the last call and
+// clears them, so repositories can append them exactly once on Save.
+func (p *Payment) PullAccrualLines() []AccrualLine {
+	lines := p.pending
+	p.pending = nil
+	return lines
+}
+
+// accrual is the outcome of compounding from the last snapshot up to a date.
+type accrual struct {
+	daysOverdue  int
+	penalty      money.Money
+	carry        decimal.Decimal
+	calculatedAt time.Time
+	lines        []AccrualLine
+}
+
+// carryScale bounds the decimal places kept for the unposted carry so the
+// exact balance does not grow without limit over long overdue periods.
+const carryScale = 18
+
+// project compounds interest without mutating the aggregate. lines is empty
+// when there is nothing new to accrue; penalty and daysOverdue then reflect
+// the current snapshot.
+//
+// Interest compounds on the exact balance (penalty plus carry). Each day posts
+// only the change in the rounded running penalty, so sub-minor-unit interest
+// keeps accumulating in the carry instead of being rounded away daily.
+func (p *Payment) project(now time.Time, resolver RateResolver) (accrual, error) {
+	if p.lockedRate != nil {
+		resolver = lockedRate{rate: *p.lockedRate}
+	}
 	anchor := p.dueDate
 	penalty, err := money.Zero(p.amount.Currency())
 	if err != nil {
-		return err
+		return accrual{}, err
 	}
+	carry := decimal.Zero
 	accumulatedDays := 0
 	if p.overdue != nil {
 		anchor = p.overdue.CalculatedAt
 		penalty = p.overdue.Penalty
+		carry = p.overdue.Carry
 		accumulatedDays = p.overdue.DaysOverdue
 	}
 
+	res := accrual{daysOverdue: accumulatedDays, penalty: penalty, carry: carry, calculatedAt: anchor}
 	if !now.After(anchor) {
-		return nil
+		return res, nil
 	}
 
 	days := daysBetween(anchor, now)
 	if days <= 0 {
-		return nil
+		return res, nil
 	}
 
 	totalDays := accumulatedDays + days
 	if totalDays > maxOverdueDays {
-		return ErrOverduePeriodTooLong
+		return accrual{}, ErrOverduePeriodTooLong
 	}
 
 	currentPenalty := penalty
+	exactPenalty := penalty.Amount().Add(carry)
+	lines := make([]AccrualLine, 0, days)
+	day := truncateToDate(anchor)
 	for i := 0; i < days; i++ {
+		day = day.AddDate(0, 0, 1)
 		base, err := p.amount.Add(currentPenalty)
 		if err != nil {
-			return err
+			return accrual{}, err
 		}
-		delta := base.MulBPS(dailyRateBPS)
-		currentPenalty, err = currentPenalty.Add(delta)
+		applied, err := resolver.ResolveDailyRate(RateQuery{
+			PaymentID:   p.id,
+			UserID:      p.userID,
+			Product:     p.product.Code,
+			Currency:    p.amount.Currency(),
+			Date:        day,
+			DaysOverdue: accumulatedDays + i + 1,
+		})
 		if err != nil {
-			return err
+			return accrual{}, err
 		}
-	}
+		if err := applied.Rate.Validate(); err != nil {
+			return accrual{}, err
+		}
+		exactBase := p.amount.Amount().Add(exactPenalty)
+		exactPenalty = exactPenalty.Add(exactBase.Mul(applied.Rate.Fraction())).Round(carryScale)
 
-	p.overdue = &OverdueInfo{
-		ID:           shared.NewID(),
-		IsOverdue:    true,
-		DaysOverdue:  totalDays,
-		Penalty:      currentPenalty,
-		CalculatedAt: truncateToDate(now),
+		posted, err := money.NewRounded(exactPenalty, p.amount.Currency(), p.product.Rounding)
+		if err != nil {
+			return accrual{}, err
+		}
+		delta, err := posted.Sub(currentPenalty)
+		if err != nil {
+			return accrual{}, err
+		}
+		currentPenalty = posted
+		lines = append(lines, AccrualLine{
+			Date:       day,
+			Base:       base,
+			ExactBase:  exactBase,
+			Rate:       applied.Rate,
+			RateSource: applied.Source,
+			Delta:      delta,
+			Penalty:    currentPenalty,
+		})
 	}
-	p.status = StatusOverdue
-	p.updatedAt = now
-	return nil
+
+	return accrual{
+		daysOverdue:  totalDays,
+		penalty:      currentPenalty,
+		carry:        exactPenalty.Sub(currentPenalty.Amount()),
+		calculatedAt: truncateToDate(now),
+		lines:        lines,
+	}, nil
 }
 
 func truncateToDate(t time.Time) time.Time {
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
 	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -113,7 +115,7 @@ func TestPay_DoublePayFails(t *testing.T) {
 	require.Equal(t, StatusPaid, p.Status())
 }
 
-func TestPay_BeforeDueDateFails(t *testing.T) {
+func TestPay_BeforeDueDateAcceptedAtFaceValue(t *testing.T) {
 	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 	uid := mustUserID(t, base)
 	amt := mustKRW(t, 10_000)
@@ -122,7 +124,21 @@ func TestPay_BeforeDueDateFails(t *testing.T) {
 	p, err := New(uid, amt, dueDate, base)
 	require.NoError(t, err)
 
-	err = p.Pay(base)
+	require.NoError(t, p.Pay(base))
+	require.Equal(t, StatusPaid, p.Status())
+	require.True(t, p.Discount().IsZero())
+}
+
+func TestPayWith_RejectEarlyPaymentFailsBeforeDueDate(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	dueDate := base.Add(24 * time.Hour)
+	p, err := New(uid, amt, dueDate, base)
+	require.NoError(t, err)
+
+	err = p.PayWith(base, RejectEarlyPayment{})
 	require.ErrorIs(t, err, ErrPaidBeforeDueDate)
 	require.Equal(t, StatusScheduled, p.Status())
 }
@@ -280,6 +296,252 @@ func TestNew_RejectsPastDueDate(t *testing.T) {
 	require.ErrorIs(t, err, ErrDueDateInPast)
 }
 
+func TestWithIDGenerator_IssuesPaymentAndOverdueIDs(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ids := shared.NewSequentialIDGenerator(base)
+	expected := shared.NewSequentialIDGenerator(base)
+
+	p, err := New(mustUserID(t, base), mustKRW(t, 10_000), base, base, WithIDGenerator(ids))
+	require.NoError(t, err)
+	require.Equal(t, expected.NewID(), p.ID())
+
+	require.NoError(t, p.AccrueInterest(base.AddDate(0, 0, 1), 100))
+	require.Equal(t, expected.NewID(), p.OverdueInfo().ID)
+	require.NoError(t, p.AccrueInterest(base.AddDate(0, 0, 2), 100))
+	second := p.OverdueInfo().ID
+	require.Equal(t, expected.NewID(), second)
+
+	restored, err := Reconstitute(Snapshot{
+		ID:        p.ID(),
+		UserID:    p.UserID(),
+		Amount:    p.Amount(),
+		DueDate:   p.DueDate(),
+		Status:    p.Status(),
+		Overdue:   p.OverdueInfo(),
+		CreatedAt: base,
+	}, WithIDGenerator(ids))
+	require.NoError(t, err)
+	require.NoError(t, restored.AccrueInterest(base.AddDate(0, 0, 3), 100))
+	require.Equal(t, expected.NewID(), restored.OverdueInfo().ID)
+	require.Less(t, second.String(), restored.OverdueInfo().ID.String())
+}
+
+type escalatingResolver struct {
+	queries []RateQuery
+}
+
+func (r *escalatingResolver) ResolveDailyRate(q RateQuery) (AppliedRate, error) {
+	r.queries = append(r.queries, q)
+	if q.DaysOverdue > 2 {
+		return AppliedRate{Rate: money.RateFromBPS(2_000), Source: "after:2d"}, nil
+	}
+	return AppliedRate{Rate: money.RateFromBPS(1_000), Source: "base"}, nil
+}
+
+func TestAccrueInterestResolved_PricesEachDay(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	p, err := New(uid, mustKRW(t, 10_000), base, base, WithProduct(Product{Code: "BNPL_30", Rounding: money.RoundHalfUp}))
+	require.NoError(t, err)
+
+	resolver := &escalatingResolver{}
+	require.NoError(t, p.AccrueInterestResolved(base.AddDate(0, 0, 2), resolver))
+	require.NoError(t, p.AccrueInterestResolved(base.AddDate(0, 0, 3), resolver))
+
+	require.Len(t, resolver.queries, 3)
+	last := resolver.queries[2]
+	require.Equal(t, p.ID(), last.PaymentID)
+	require.Equal(t, uid, last.UserID)
+	require.Equal(t, "BNPL_30", last.Product)
+	require.Equal(t, money.CurrencyKRW, last.Currency)
+	require.Equal(t, base.AddDate(0, 0, 3), last.Date)
+	require.Equal(t, 3, last.DaysOverdue)
+
+	lines := p.PullAccrualLines()
+	require.Len(t, lines, 3)
+	require.Equal(t, "base", lines[1].RateSource)
+	require.Equal(t, "after:2d", lines[2].RateSource)
+	require.True(t, lines[2].Rate.Equal(money.RateFromBPS(2_000)))
+	// 10000 → +1000 → +1100 → +20% of 12100 = +2420
+	require.Equal(t, "4520", p.OverdueInfo().Penalty.Amount().String())
+}
+
+func TestAccrueInterest_RecordsDailyLedger(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := New(uid, amt, base, base)
+	require.NoError(t, err)
+
+	require.NoError(t, p.AccrueInterest(base.Add(24*time.Hour), 1_000))
+	require.NoError(t, p.AccrueInterest(base.Add(72*time.Hour), 1_000))
+
+	lines := p.PullAccrualLines()
+	require.Len(t, lines, 3)
+	wantBases := []int64{10_000, 11_000, 12_100}
+	wantDeltas := []int64{1_000, 1_100, 1_210}
+	wantPenalties := []int64{1_000, 2_100, 3_310}
+	for i, line := range lines {
+		require.Equal(t, base.AddDate(0, 0, i+1), line.Date)
+		require.True(t, line.Base.Amount().Equal(mustKRW(t, wantBases[i]).Amount()))
+		require.True(t, line.Delta.Amount().Equal(mustKRW(t, wantDeltas[i]).Amount()))
+		require.True(t, line.Penalty.Amount().Equal(mustKRW(t, wantPenalties[i]).Amount()))
+	}
+
+	require.Empty(t, p.PullAccrualLines())
+}
+
+func TestReconstitute_RestoresStateAndVersion(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := New(uid, amt, base, base)
+	require.NoError(t, err)
+	require.NoError(t, p.AccrueInterest(base.Add(24*time.Hour), 1_000))
+
+	restored, err := Reconstitute(Snapshot{
+		ID:        p.ID(),
+		UserID:    p.UserID(),
+		Amount:    p.Amount(),
+		DueDate:   p.DueDate(),
+		Status:    p.Status(),
+		Overdue:   p.OverdueInfo(),
+		CreatedAt: p.CreatedAt(),
+		UpdatedAt: p.UpdatedAt(),
+		Version:   4,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), restored.Version())
+	require.Equal(t, StatusOverdue, restored.Status())
+
+	require.NoError(t, restored.AccrueInterest(base.Add(72*time.Hour), 1_000))
+	require.True(t, restored.OverdueInfo().Penalty.Amount().Equal(mustKRW(t, 3_310).Amount()))
+}
+
+func TestReconstitute_Validation(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	_, err := Reconstitute(Snapshot{UserID: uid, Amount: amt, DueDate: base, Status: StatusScheduled})
+	require.ErrorIs(t, err, ErrInvalidPaymentID)
+
+	_, err = Reconstitute(Snapshot{ID: shared.NewID(), UserID: uid, Amount: amt, DueDate: base, Status: "UNKNOWN"})
+	require.ErrorIs(t, err, ErrInvalidStatus)
+
+	_, err = Reconstitute(Snapshot{ID: shared.NewID(), UserID: uid, Amount: amt, DueDate: base, Status: StatusPaid})
+	require.ErrorIs(t, err, ErrInvalidPaidAt)
+}
+
+func TestAccrueInterest_UsesProductRounding(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 1_000)
+
+	halfUp, err := New(uid, amt, base, base)
+	require.NoError(t, err)
+	require.NoError(t, halfUp.AccrueInterest(base.Add(24*time.Hour), 125)) // 12.5
+	require.True(t, halfUp.OverdueInfo().Penalty.Amount().Equal(mustKRW(t, 13).Amount()))
+
+	banker := Product{Code: "BNPL-KR", Rounding: money.RoundHalfEven}
+	halfEven, err := New(uid, amt, base, base, WithProduct(banker))
+	require.NoError(t, err)
+	require.Equal(t, banker, halfEven.Product())
+	require.NoError(t, halfEven.AccrueInterest(base.Add(24*time.Hour), 125))
+	require.True(t, halfEven.OverdueInfo().Penalty.Amount().Equal(mustKRW(t, 12).Amount()))
+}
+
+func TestNew_RejectsInvalidProduct(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	_, err := New(uid, amt, base, base, WithProduct(Product{Code: "X", Rounding: "SIDEWAYS"}))
+	require.ErrorIs(t, err, ErrInvalidProduct)
+}
+
+func TestAccrueInterest_CarriesSubMinorUnitInterest(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt, err := money.FromMinor(100, money.CurrencyUSD) // $1.00 earns $0.0001 a day at 1 bps
+	require.NoError(t, err)
+
+	p, err := New(uid, amt, base, base)
+	require.NoError(t, err)
+	require.NoError(t, p.AccrueInterest(base.AddDate(0, 0, 1), 1))
+	require.True(t, p.OverdueInfo().Penalty.IsZero())
+	require.Equal(t, "0.0001", p.OverdueInfo().Carry.String())
+
+	for day := 2; day <= 365; day++ {
+		require.NoError(t, p.AccrueInterest(base.AddDate(0, 0, day), 1))
+	}
+
+	// exact: 1.00 * (1.0001^365 - 1) = 0.03716...
+	exact := decimal.NewFromInt(1).Add(decimal.RequireFromString("0.0001")).Pow(decimal.NewFromInt(365)).Sub(decimal.NewFromInt(1))
+	info := p.OverdueInfo()
+	require.Equal(t, "0.04", info.Penalty.Amount().StringFixed(2))
+	require.True(t, info.Penalty.Amount().Sub(exact).Abs().LessThanOrEqual(decimal.RequireFromString("0.01")))
+	require.True(t, info.Penalty.Amount().Add(info.Carry).Sub(exact).Abs().LessThan(decimal.RequireFromString("0.000000001")))
+}
+
+func TestAccrueInterest_LedgerReconcilesWithExactBase(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	amt, err := money.FromMinor(1_234, money.CurrencyUSD)
+	require.NoError(t, err)
+
+	p, err := New(mustUserID(t, base), amt, base, base)
+	require.NoError(t, err)
+	require.NoError(t, p.AccrueInterest(base.AddDate(0, 0, 30), 7))
+
+	interest := decimal.Zero
+	for _, line := range p.PullAccrualLines() {
+		// The carry is at most half a cent either way under half-up rounding.
+		require.True(t, line.ExactBase.Sub(line.Base.Amount()).Abs().LessThanOrEqual(decimal.RequireFromString("0.005")))
+		interest = interest.Add(line.ExactBase.Mul(line.Rate.Fraction()))
+	}
+	info := p.OverdueInfo()
+	require.True(t, interest.Sub(info.Penalty.Amount().Add(info.Carry)).Abs().LessThan(decimal.RequireFromString("0.000000001")))
+}
+
+func TestAccrueInterest_CarryMatchesSingleAccrual(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt, err := money.FromMinor(1_234, money.CurrencyUSD)
+	require.NoError(t, err)
+
+	daily, err := New(uid, amt, base, base)
+	require.NoError(t, err)
+	for day := 1; day <= 90; day++ {
+		require.NoError(t, daily.AccrueInterest(base.AddDate(0, 0, day), 7))
+	}
+
+	once, err := New(uid, amt, base, base)
+	require.NoError(t, err)
+	require.NoError(t, once.AccrueInterest(base.AddDate(0, 0, 90), 7))
+
+	require.Equal(t, once.OverdueInfo().Penalty, daily.OverdueInfo().Penalty)
+	require.True(t, once.OverdueInfo().Carry.Equal(daily.OverdueInfo().Carry))
+
+	var posted decimal.Decimal
+	for _, line := range daily.PullAccrualLines() {
+		posted = posted.Add(line.Delta.Amount())
+	}
+	require.True(t, posted.Equal(daily.OverdueInfo().Penalty.Amount()))
+}
+
+func TestAccrueInterestRate_RejectsNegativeRate(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p, err := New(mustUserID(t, base), mustKRW(t, 10_000), base, base)
+	require.NoError(t, err)
+
+	err = p.AccrueInterestRate(base.AddDate(0, 0, 2), money.RateFromBPS(-10))
+	require.ErrorIs(t, err, money.ErrInvalidRate)
+	require.Nil(t, p.OverdueInfo())
+	require.Equal(t, StatusScheduled, p.Status())
+}
+
 func mustUserID(t *testing.T, now time.Time) user.ID {
 	u, err := user.New("tester", now)
 	require.NoError(t, err)
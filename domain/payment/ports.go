@@ -1,6 +1,12 @@
 package payment
 
-import "time"
+import (
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+)
 
 // Clock abstracts time retrieval for deterministic tests.
 type Clock interface {
@@ -19,6 +25,37 @@ type DailyRateProvider interface {
 	DailyRateBPS(at time.Time) (int64, error)
 }
 
+// DailyRater is implemented by providers that can express fractional basis
+// points. Accrual prefers it over DailyRateBPS when a provider offers both.
+type DailyRater interface {
+	DailyRate(at time.Time) (money.Rate, error)
+}
+
+// RateQuery carries the payment context a daily rate is priced for.
+type RateQuery struct {
+	PaymentID shared.ID
+	UserID    user.ID
+	Product   string
+	Currency  money.Currency
+	// Date is the accrual day being priced.
+	Date time.Time
+	// DaysOverdue includes Date, so the first day past due is 1.
+	DaysOverdue int
+}
+
+// AppliedRate is the daily rate chosen for one accrual day together with the
+// rule that chose it, recorded on the AccrualLine for audit.
+type AppliedRate struct {
+	Rate   money.Rate
+	Source string
+}
+
+// RateResolver prices every accrual day from the payment context, so rates can
+// depend on the customer, the product or how long the payment is overdue.
+type RateResolver interface {
+	ResolveDailyRate(q RateQuery) (AppliedRate, error)
+}
+
 // FixedClock returns a fixed time, useful for tests.
 type FixedClock struct {
 	NowTime time.Time
@@ -37,3 +74,61 @@ type StaticDailyRate struct {
 func (r StaticDailyRate) DailyRateBPS(time.Time) (int64, error) {
 	return r.BPS, r.Err
 }
+
+// StaticRate always returns the configured high-precision rate. DailyRateBPS
+// reports it rounded to whole basis points for BPS-only callers.
+type StaticRate struct {
+	Rate money.Rate
+	Err  error
+}
+
+func (r StaticRate) DailyRate(time.Time) (money.Rate, error) {
+	return r.Rate, r.Err
+}
+
+func (r StaticRate) DailyRateBPS(time.Time) (int64, error) {
+	return r.Rate.BPS().Round(0).IntPart(), r.Err
+}
+
+// flatRate prices every day with the same rate.
+type flatRate struct {
+	rate money.Rate
+}
+
+func (r flatRate) ResolveDailyRate(RateQuery) (AppliedRate, error) {
+	return AppliedRate{Rate: r.rate}, nil
+}
+
+// lockedRate prices a payment whose rate was fixed at origination.
+type lockedRate struct {
+	rate money.Rate
+}
+
+func (r lockedRate) ResolveDailyRate(RateQuery) (AppliedRate, error) {
+	return AppliedRate{Rate: r.rate, Source: "locked"}, nil
+}
+
+// providerRates asks a DailyRateProvider for the rate of each accrual day.
+type providerRates struct {
+	provider DailyRateProvider
+}
+
+func (r providerRates) ResolveDailyRate(q RateQuery) (AppliedRate, error) {
+	rate, err := dailyRateAt(r.provider, q.Date)
+	if err != nil {
+		return AppliedRate{}, err
+	}
+	return AppliedRate{Rate: rate}, nil
+}
+
+// dailyRateAt resolves the rate from a provider, using DailyRater when available.
+func dailyRateAt(provider DailyRateProvider, at time.Time) (money.Rate, error) {
+	if rater, ok := provider.(DailyRater); ok {
+		return rater.DailyRate(at)
+	}
+	bps, err := provider.DailyRateBPS(at)
+	if err != nil {
+		return money.Rate{}, err
+	}
+	return money.RateFromBPS(bps), nil
+}
@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+)
+
+// Product carries pricing configuration shared by payments of the same offering.
+type Product struct {
+	Code     string
+	Rounding money.RoundingMode
+}
+
+// DefaultProduct is used when a payment is created without WithProduct.
+var DefaultProduct = Product{Code: "DEFAULT", Rounding: money.RoundHalfUp}
+
+func (p Product) validate() error {
+	if p.Code == "" || !p.Rounding.IsValid() {
+		return ErrInvalidProduct
+	}
+	return nil
+}
+
+// Option customizes a Payment at creation.
+type Option func(*Payment)
+
+// WithProduct attaches the product whose configuration drives accrual.
+func WithProduct(product Product) Option {
+	return func(p *Payment) {
+		p.product = product
+	}
+}
+
+// WithIDGenerator issues the payment ID and the IDs of overdue snapshots
+// recorded by MarkOverdue and AccrueInterest from gen.
+func WithIDGenerator(gen shared.IDGenerator) Option {
+	return func(p *Payment) {
+		if gen != nil {
+			p.ids = gen
+		}
+	}
+}
+
+// WithLockedRate snapshots provider's daily rate at creation time and keeps
+// accruing at it for the life of the payment, so later rate table changes do
+// not reprice existing contracts. New fails if the provider cannot supply a rate.
+func WithLockedRate(provider DailyRateProvider) Option {
+	return func(p *Payment) {
+		p.lockFrom = provider
+	}
+}
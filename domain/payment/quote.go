@@ -0,0 +1,77 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/shopspring/decimal"
+)
+
+// AccrualLine captures a single day of compounding. Delta is the amount posted
+// that day; interest below the minor unit stays in OverdueInfo.Carry.
+type AccrualLine struct {
+	Date time.Time
+	// Base is principal plus the posted penalty, for display.
+	Base money.Money
+	// ExactBase adds the unposted carry to Base; ExactBase × Rate is the day's
+	// exact interest, of which Delta is the part that crossed a minor unit.
+	ExactBase decimal.Decimal
+	Rate      money.Rate
+	// RateSource names the pricing rule behind Rate (see AppliedRate); empty
+	// for a flat rate.
+	RateSource string
+	Delta      money.Money
+	Penalty    money.Money
+}
+
+// Quote is a read-only payoff projection for a given date.
+type Quote struct {
+	PaymentID   shared.ID
+	AsOf        time.Time
+	Principal   money.Money
+	Penalty     money.Money
+	Total       money.Money
+	DaysOverdue int
+	// Days lists only the days projected beyond the last accrual snapshot.
+	Days []AccrualLine
+}
+
+// QuoteAt projects what AccrueInterestWith would produce on the given date
+// without mutating the aggregate.
+func (p *Payment) QuoteAt(date time.Time, rateProvider DailyRateProvider) (Quote, error) {
+	return p.QuoteAtResolved(date, providerRates{provider: rateProvider})
+}
+
+// QuoteAtResolved projects what AccrueInterestResolved would produce on the
+// given date without mutating the aggregate. A quote is the amount Pay would
+// settle, so a paid payment fails with ErrPaymentAlreadyPaid as Pay does,
+// rather than the accrual path's ErrPaidPaymentCannotOverdue.
+func (p *Payment) QuoteAtResolved(date time.Time, resolver RateResolver) (Quote, error) {
+	if date.IsZero() {
+		return Quote{}, ErrInvalidOverdueArgs
+	}
+	if p.status == StatusPaid {
+		return Quote{}, ErrPaymentAlreadyPaid
+	}
+
+	res, err := p.project(date, resolver)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	total, err := p.amount.Add(res.penalty)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	return Quote{
+		PaymentID:   p.id,
+		AsOf:        truncateToDate(date),
+		Principal:   p.amount,
+		Penalty:     res.penalty,
+		Total:       total,
+		DaysOverdue: res.daysOverdue,
+		Days:        res.lines,
+	}, nil
+}
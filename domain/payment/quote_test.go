@@ -0,0 +1,107 @@
+package payment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteAt_MatchesAccrueWithoutMutating(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := New(uid, amt, base, base)
+	require.NoError(t, err)
+
+	friday := base.Add(72 * time.Hour)
+	rate := StaticDailyRate{BPS: 1_000}
+
+	quote, err := p.QuoteAt(friday, rate)
+	require.NoError(t, err)
+	require.Nil(t, p.OverdueInfo())
+	require.Equal(t, StatusScheduled, p.Status())
+
+	require.Equal(t, 3, quote.DaysOverdue)
+	require.True(t, quote.Principal.Amount().Equal(amt.Amount()))
+	require.True(t, quote.Penalty.Amount().Equal(mustKRW(t, 3_310).Amount()))
+	require.True(t, quote.Total.Amount().Equal(mustKRW(t, 13_310).Amount()))
+	require.Len(t, quote.Days, 3)
+
+	wantDeltas := []int64{1_000, 1_100, 1_210}
+	for i, line := range quote.Days {
+		require.Equal(t, base.AddDate(0, 0, i+1), line.Date)
+		require.True(t, line.Rate.Equal(money.RateFromBPS(1_000)))
+		require.True(t, line.Delta.Amount().Equal(mustKRW(t, wantDeltas[i]).Amount()))
+	}
+
+	require.NoError(t, p.AccrueInterestWith(FixedClock{NowTime: friday}, rate))
+	info := p.OverdueInfo()
+	require.Equal(t, info.DaysOverdue, quote.DaysOverdue)
+	require.True(t, info.Penalty.Amount().Equal(quote.Penalty.Amount()))
+}
+
+func TestQuoteAt_ContinuesFromSnapshot(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := New(uid, amt, base, base)
+	require.NoError(t, err)
+	require.NoError(t, p.AccrueInterest(base.Add(24*time.Hour), 1_000))
+
+	quote, err := p.QuoteAt(base.Add(72*time.Hour), StaticDailyRate{BPS: 1_000})
+	require.NoError(t, err)
+	require.Equal(t, 3, quote.DaysOverdue)
+	require.Len(t, quote.Days, 2)
+	require.True(t, quote.Penalty.Amount().Equal(mustKRW(t, 3_310).Amount()))
+	require.Equal(t, 1, p.OverdueInfo().DaysOverdue)
+}
+
+func TestQuoteAt_BeforeDueReturnsPrincipal(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := New(uid, amt, base.Add(48*time.Hour), base)
+	require.NoError(t, err)
+
+	quote, err := p.QuoteAt(base, StaticDailyRate{BPS: 1_000})
+	require.NoError(t, err)
+	require.Equal(t, 0, quote.DaysOverdue)
+	require.True(t, quote.Penalty.IsZero())
+	require.True(t, quote.Total.Amount().Equal(amt.Amount()))
+	require.Empty(t, quote.Days)
+}
+
+func TestQuoteAt_FailsWhenPaid(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := New(uid, amt, base, base)
+	require.NoError(t, err)
+	require.NoError(t, p.Pay(base))
+
+	_, err = p.QuoteAt(base.Add(24*time.Hour), StaticDailyRate{BPS: 1_000})
+	require.ErrorIs(t, err, ErrPaymentAlreadyPaid)
+}
+
+func TestAccrueInterestWith_PrefersHighPrecisionRate(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+
+	p, err := New(uid, mustKRW(t, 1_000_000), base, base)
+	require.NoError(t, err)
+
+	rate := money.RateFromFraction(decimal.RequireFromString("0.00054794520547945")) // 20% APR / 365
+	require.NoError(t, p.AccrueInterestWith(FixedClock{NowTime: base.Add(24 * time.Hour)}, StaticRate{Rate: rate}))
+
+	lines := p.PullAccrualLines()
+	require.Len(t, lines, 1)
+	require.True(t, lines[0].Rate.Equal(rate))
+	require.True(t, lines[0].Delta.Amount().Equal(decimal.NewFromInt(548)))
+}
@@ -0,0 +1,95 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+)
+
+// CachedRates memoizes a provider per UTC date, so repeated accruals on the
+// same day hit the underlying source once. Errors are not cached.
+type CachedRates struct {
+	provider DailyRateProvider
+
+	mu    sync.Mutex
+	rates map[time.Time]money.Rate
+}
+
+func NewCachedRates(provider DailyRateProvider) *CachedRates {
+	return &CachedRates{provider: provider, rates: make(map[time.Time]money.Rate)}
+}
+
+func (c *CachedRates) DailyRate(at time.Time) (money.Rate, error) {
+	day := truncateToDate(at)
+	c.mu.Lock()
+	rate, ok := c.rates[day]
+	c.mu.Unlock()
+	if ok {
+		return rate, nil
+	}
+
+	rate, err := dailyRateAt(c.provider, at)
+	if err != nil {
+		return money.Rate{}, err
+	}
+	c.mu.Lock()
+	c.rates[day] = rate
+	c.mu.Unlock()
+	return rate, nil
+}
+
+func (c *CachedRates) DailyRateBPS(at time.Time) (int64, error) {
+	rate, err := c.DailyRate(at)
+	if err != nil {
+		return 0, err
+	}
+	return rate.BPS().Round(0).IntPart(), nil
+}
+
+// Invalidate drops every cached rate, e.g. after a rate table change.
+func (c *CachedRates) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.rates)
+}
+
+// FallbackRates asks each provider in order and returns the first rate
+// supplied, so one flaky source does not stall accrual. When all fail the
+// error wraps ErrNoRateAvailable together with every provider's error.
+type FallbackRates struct {
+	providers []DailyRateProvider
+}
+
+func NewFallbackRates(providers ...DailyRateProvider) *FallbackRates {
+	return &FallbackRates{providers: providers}
+}
+
+func (f *FallbackRates) DailyRate(at time.Time) (money.Rate, error) {
+	errs := make([]error, 0, len(f.providers))
+	for i, provider := range f.providers {
+		rate, err := dailyRateAt(provider, at)
+		if err == nil {
+			return rate, nil
+		}
+		errs = append(errs, fmt.Errorf("provider %d: %w", i, err))
+	}
+	return money.Rate{}, fmt.Errorf("%w: %w", ErrNoRateAvailable, errors.Join(errs...))
+}
+
+func (f *FallbackRates) DailyRateBPS(at time.Time) (int64, error) {
+	rate, err := f.DailyRate(at)
+	if err != nil {
+		return 0, err
+	}
+	return rate.BPS().Round(0).IntPart(), nil
+}
+
+var (
+	_ DailyRateProvider = (*CachedRates)(nil)
+	_ DailyRater        = (*CachedRates)(nil)
+	_ DailyRateProvider = (*FallbackRates)(nil)
+	_ DailyRater        = (*FallbackRates)(nil)
+)
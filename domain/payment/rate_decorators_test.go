@@ -0,0 +1,191 @@
+package payment
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/stretchr/testify/require"
+)
+
+type countingRates struct {
+	rate  money.Rate
+	err   error
+	calls int
+}
+
+func (r *countingRates) DailyRate(time.Time) (money.Rate, error) {
+	r.calls++
+	return r.rate, r.err
+}
+
+func (r *countingRates) DailyRateBPS(at time.Time) (int64, error) {
+	rate, err := r.DailyRate(at)
+	return rate.BPS().Round(0).IntPart(), err
+}
+
+func TestCachedRates_HitsProviderOncePerDay(t *testing.T) {
+	day := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	inner := &countingRates{rate: money.RateFromBPS(10)}
+	cached := NewCachedRates(inner)
+
+	for _, at := range []time.Time{day, day.Add(6 * time.Hour), day.Add(12 * time.Hour)} {
+		rate, err := cached.DailyRate(at)
+		require.NoError(t, err)
+		require.True(t, rate.Equal(money.RateFromBPS(10)))
+	}
+	require.Equal(t, 1, inner.calls)
+
+	bps, err := cached.DailyRateBPS(day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), bps)
+	require.Equal(t, 2, inner.calls)
+
+	cached.Invalidate()
+	_, err = cached.DailyRate(day)
+	require.NoError(t, err)
+	require.Equal(t, 3, inner.calls)
+}
+
+type datedRates struct {
+	bps   map[time.Time]int64
+	calls int
+}
+
+func (r *datedRates) DailyRateBPS(at time.Time) (int64, error) {
+	r.calls++
+	return r.bps[at], nil
+}
+
+func TestAccrueInterestWith_CachedRatesPricesEachDayOnce(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	inner := &datedRates{bps: map[time.Time]int64{
+		base.AddDate(0, 0, 1): 1_000,
+		base.AddDate(0, 0, 2): 2_000,
+	}}
+	cached := NewCachedRates(inner)
+	p, err := New(mustUserID(t, base), mustKRW(t, 10_000), base, base)
+	require.NoError(t, err)
+
+	now := base.AddDate(0, 0, 2).Add(9 * time.Hour)
+	quote, err := p.QuoteAt(now, cached)
+	require.NoError(t, err)
+	require.NoError(t, p.AccrueInterestWith(FixedClock{NowTime: now}, cached))
+
+	// day 1: 10% of 10000 = 1000; day 2: 20% of 11000 = 2200
+	require.Equal(t, "3200", quote.Penalty.Amount().String())
+	require.Equal(t, "3200", p.OverdueInfo().Penalty.Amount().String())
+	require.Equal(t, 2, inner.calls)
+}
+
+func TestCachedRates_DoesNotCacheErrors(t *testing.T) {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	inner := &countingRates{err: errors.New("feed down")}
+	cached := NewCachedRates(inner)
+
+	_, err := cached.DailyRate(day)
+	require.Error(t, err)
+
+	inner.err = nil
+	inner.rate = money.RateFromBPS(5)
+	rate, err := cached.DailyRate(day)
+	require.NoError(t, err)
+	require.True(t, rate.Equal(money.RateFromBPS(5)))
+	require.Equal(t, 2, inner.calls)
+}
+
+func TestFallbackRates_UsesFirstAvailable(t *testing.T) {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feedDown := errors.New("feed down")
+	primary := &countingRates{err: feedDown}
+	secondary := StaticRate{Rate: money.RateFromBPS(7)}
+	unused := &countingRates{rate: money.RateFromBPS(99)}
+
+	rate, err := NewFallbackRates(primary, secondary, unused).DailyRate(day)
+	require.NoError(t, err)
+	require.True(t, rate.Equal(money.RateFromBPS(7)))
+	require.Equal(t, 1, primary.calls)
+	require.Zero(t, unused.calls)
+
+	tableMissing := errors.New("no table")
+	_, err = NewFallbackRates(primary, StaticDailyRate{Err: tableMissing}).DailyRateBPS(day)
+	require.ErrorIs(t, err, ErrNoRateAvailable)
+	require.ErrorIs(t, err, feedDown)
+	require.ErrorIs(t, err, tableMissing)
+
+	_, err = NewFallbackRates().DailyRate(day)
+	require.ErrorIs(t, err, ErrNoRateAvailable)
+}
+
+func TestWithLockedRate_IgnoresLaterRateChanges(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	provider := &countingRates{rate: money.RateFromBPS(1_000)}
+
+	p, err := New(mustUserID(t, base), mustKRW(t, 10_000), base, base, WithLockedRate(provider))
+	require.NoError(t, err)
+	locked, ok := p.LockedRate()
+	require.True(t, ok)
+	require.True(t, locked.Equal(money.RateFromBPS(1_000)))
+
+	provider.rate = money.RateFromBPS(5_000)
+	require.NoError(t, p.AccrueInterestWith(FixedClock{NowTime: base.AddDate(0, 0, 1)}, provider))
+	require.NoError(t, p.AccrueInterest(base.AddDate(0, 0, 2), 5_000))
+	require.Equal(t, "2100", p.OverdueInfo().Penalty.Amount().String())
+
+	lines := p.PullAccrualLines()
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		require.Equal(t, "locked", line.RateSource)
+		require.True(t, line.Rate.Equal(money.RateFromBPS(1_000)))
+	}
+	require.Equal(t, 1, provider.calls)
+
+	restored, err := Reconstitute(Snapshot{
+		ID:         p.ID(),
+		UserID:     p.UserID(),
+		Amount:     p.Amount(),
+		DueDate:    p.DueDate(),
+		Status:     p.Status(),
+		Overdue:    p.OverdueInfo(),
+		LockedRate: &locked,
+		CreatedAt:  base,
+	})
+	require.NoError(t, err)
+	restoredRate, ok := restored.LockedRate()
+	require.True(t, ok)
+	require.True(t, restoredRate.Equal(locked))
+}
+
+func TestReconstitute_IgnoresWithLockedRate(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	provider := &countingRates{rate: money.RateFromBPS(1_000)}
+	p, err := New(mustUserID(t, base), mustKRW(t, 10_000), base, base)
+	require.NoError(t, err)
+
+	restored, err := Reconstitute(Snapshot{
+		ID:        p.ID(),
+		UserID:    p.UserID(),
+		Amount:    p.Amount(),
+		DueDate:   p.DueDate(),
+		Status:    p.Status(),
+		CreatedAt: base,
+	}, WithLockedRate(provider))
+	require.NoError(t, err)
+	_, ok := restored.LockedRate()
+	require.False(t, ok)
+	require.Zero(t, provider.calls)
+}
+
+func TestWithLockedRate_FailsWithoutRate(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feedDown := errors.New("feed down")
+
+	_, err := New(mustUserID(t, base), mustKRW(t, 10_000), base, base, WithLockedRate(StaticDailyRate{Err: feedDown}))
+	require.ErrorIs(t, err, feedDown)
+
+	p, err := New(mustUserID(t, base), mustKRW(t, 10_000), base, base)
+	require.NoError(t, err)
+	_, ok := p.LockedRate()
+	require.False(t, ok)
+}
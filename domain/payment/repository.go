@@ -4,10 +4,22 @@ import (
 	"context"
 
 	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
 )
 
-// Repository abstracts persistence for the Payment aggregate.
+// Repository abstracts persistence for the Payment aggregate. Save fails with
+// ErrConcurrentModification when the stored version differs from Version().
 type Repository interface {
 	Get(ctx context.Context, id shared.ID) (*Payment, error)
 	Save(ctx context.Context, payment *Payment) error
 }
+
+// AccrualLedger reads the per-day accrual history persisted alongside payments.
+type AccrualLedger interface {
+	ListAccrualLines(ctx context.Context, paymentID shared.ID) ([]AccrualLine, error)
+}
+
+// UserPayments lists every payment owed by a user, e.g. to compute exposure.
+type UserPayments interface {
+	ListByUser(ctx context.Context, userID user.ID) ([]*Payment, error)
+}
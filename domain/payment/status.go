@@ -7,3 +7,12 @@ const (
 	StatusPaid      Status = "PAID"
 	StatusOverdue   Status = "OVERDUE"
 )
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusScheduled, StatusPaid, StatusOverdue:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,18 @@
+package plan
+
+import "errors"
+
+var (
+	ErrInvalidPlanID           = errors.New("invalid plan id")
+	ErrInvalidUserID           = errors.New("invalid user id")
+	ErrInvalidTotal            = errors.New("invalid plan total")
+	ErrInvalidInstallmentCount = errors.New("invalid installment count")
+	ErrInvalidFrequency        = errors.New("invalid installment frequency")
+	ErrInvalidRemainder        = errors.New("invalid remainder placement")
+	ErrInvalidStatus           = errors.New("invalid plan status")
+	ErrInvalidInstallments     = errors.New("installments do not match plan total")
+	ErrUnknownInstallment      = errors.New("payment does not belong to plan")
+	ErrPlanNotFound            = errors.New("plan not found")
+	ErrConcurrentModification  = errors.New("plan modified concurrently")
+	ErrUnsupportedPaymentEvent = errors.New("unsupported payment event")
+)
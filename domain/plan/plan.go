@@ -0,0 +1,337 @@
+package plan
+
+import (
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+)
+
+const (
+	maxInstallments         = 52
+	defaultDefaultAfterDays = 90
+)
+
+// Terms describes how a purchase is split into scheduled payments.
+type Terms struct {
+	Total        money.Money
+	Installments int
+	Frequency    Frequency
+	FirstDueDate time.Time
+	Remainder    RemainderPlacement
+	// Product is applied to every installment payment; empty uses the payment default.
+	Product payment.Product
+	// DefaultAfterDays is how long an installment may stay overdue before the
+	// plan defaults. Zero means 90 days.
+	DefaultAfterDays int
+}
+
+// Installment links a plan slot to the Payment that collects it.
+type Installment struct {
+	Seq       int
+	PaymentID shared.ID
+	Amount    money.Money
+	DueDate   time.Time
+	Paid      bool
+}
+
+// Plan is the aggregate root for a "pay in N" purchase.
+type Plan struct {
+	id               shared.ID
+	userID           user.ID
+	total            money.Money
+	frequency        Frequency
+	remainder        RemainderPlacement
+	defaultAfterDays int
+	installments     []Installment
+	status           Status
+	createdAt        time.Time
+	updatedAt        time.Time
+	version          int64
+}
+
+// Option customizes plan creation.
+type Option func(*options)
+
+type options struct {
+	ids shared.IDGenerator
+}
+
+// WithIDGenerator issues the plan ID and its installment payment IDs from gen
+// instead of the process-wide generator.
+func WithIDGenerator(gen shared.IDGenerator) Option {
+	return func(o *options) {
+		if gen != nil {
+			o.ids = gen
+		}
+	}
+}
+
+// New creates a plan together with the payments for each installment. The
+// installment amounts always sum exactly to the total.
+func New(userID user.ID, terms Terms, now time.Time, opts ...Option) (*Plan, []*payment.Payment, error) {
+	o := options{ids: shared.DefaultIDGenerator()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if userID.IsZero() {
+		return nil, nil, ErrInvalidUserID
+	}
+	if !terms.Total.IsPositive() {
+		return nil, nil, ErrInvalidTotal
+	}
+	if terms.Installments < 1 || terms.Installments > maxInstallments {
+		return nil, nil, ErrInvalidInstallmentCount
+	}
+	if !terms.Frequency.IsValid() {
+		return nil, nil, ErrInvalidFrequency
+	}
+	if terms.Remainder == "" {
+		terms.Remainder = RemainderFirst
+	}
+	if !terms.Remainder.IsValid() {
+		return nil, nil, ErrInvalidRemainder
+	}
+	if terms.DefaultAfterDays <= 0 {
+		terms.DefaultAfterDays = defaultDefaultAfterDays
+	}
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	remainderAt := 0
+	if terms.Remainder == RemainderLast {
+		remainderAt = terms.Installments - 1
+	}
+	amounts, err := terms.Total.SplitEven(terms.Installments, remainderAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paymentOpts := []payment.Option{payment.WithIDGenerator(o.ids)}
+	if terms.Product.Code != "" {
+		paymentOpts = append(paymentOpts, payment.WithProduct(terms.Product))
+	}
+
+	installments := make([]Installment, 0, terms.Installments)
+	payments := make([]*payment.Payment, 0, terms.Installments)
+	for i, amount := range amounts {
+		dueDate := dueDateFor(terms.FirstDueDate, terms.Frequency, i)
+		p, err := payment.New(userID, amount, dueDate, now, paymentOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		payments = append(payments, p)
+		installments = append(installments, Installment{
+			Seq:       i + 1,
+			PaymentID: p.ID(),
+			Amount:    amount,
+			DueDate:   p.DueDate(),
+		})
+	}
+
+	return &Plan{
+		id:               o.ids.NewID(),
+		userID:           userID,
+		total:            terms.Total,
+		frequency:        terms.Frequency,
+		remainder:        terms.Remainder,
+		defaultAfterDays: terms.DefaultAfterDays,
+		installments:     installments,
+		status:           StatusActive,
+		createdAt:        now,
+		updatedAt:        now,
+	}, payments, nil
+}
+
+// Snapshot carries persisted state used to rebuild a Plan.
+type Snapshot struct {
+	ID               shared.ID
+	UserID           user.ID
+	Total            money.Money
+	Frequency        Frequency
+	Remainder        RemainderPlacement
+	DefaultAfterDays int
+	Installments     []Installment
+	Status           Status
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Version          int64
+}
+
+// Reconstitute rebuilds a Plan from storage without replaying events.
+func Reconstitute(s Snapshot) (*Plan, error) {
+	if shared.IsZero(s.ID) {
+		return nil, ErrInvalidPlanID
+	}
+	if s.UserID.IsZero() {
+		return nil, ErrInvalidUserID
+	}
+	if !s.Total.IsPositive() {
+		return nil, ErrInvalidTotal
+	}
+	if !s.Frequency.IsValid() {
+		return nil, ErrInvalidFrequency
+	}
+	if !s.Remainder.IsValid() {
+		return nil, ErrInvalidRemainder
+	}
+	if !s.Status.IsValid() {
+		return nil, ErrInvalidStatus
+	}
+	if len(s.Installments) == 0 {
+		return nil, ErrInvalidInstallmentCount
+	}
+
+	amounts := make([]money.Money, len(s.Installments))
+	for i, inst := range s.Installments {
+		amounts[i] = inst.Amount
+	}
+	sum, err := money.Sum(amounts...)
+	if err != nil {
+		return nil, err
+	}
+	if !sum.Equal(s.Total) {
+		return nil, ErrInvalidInstallments
+	}
+
+	installments := make([]Installment, len(s.Installments))
+	copy(installments, s.Installments)
+	return &Plan{
+		id:               s.ID,
+		userID:           s.UserID,
+		total:            s.Total,
+		frequency:        s.Frequency,
+		remainder:        s.Remainder,
+		defaultAfterDays: s.DefaultAfterDays,
+		installments:     installments,
+		status:           s.Status,
+		createdAt:        s.CreatedAt,
+		updatedAt:        s.UpdatedAt,
+		version:          s.Version,
+	}, nil
+}
+
+func (p *Plan) ID() shared.ID {
+	return p.id
+}
+
+func (p *Plan) UserID() user.ID {
+	return p.userID
+}
+
+func (p *Plan) Total() money.Money {
+	return p.total
+}
+
+func (p *Plan) Frequency() Frequency {
+	return p.frequency
+}
+
+func (p *Plan) Remainder() RemainderPlacement {
+	return p.remainder
+}
+
+func (p *Plan) DefaultAfterDays() int {
+	return p.defaultAfterDays
+}
+
+func (p *Plan) Installments() []Installment {
+	installments := make([]Installment, len(p.installments))
+	copy(installments, p.installments)
+	return installments
+}
+
+func (p *Plan) Status() Status {
+	return p.status
+}
+
+func (p *Plan) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Plan) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// Version is the persisted revision the aggregate was loaded at; zero means never saved.
+func (p *Plan) Version() int64 {
+	return p.version
+}
+
+// IncrementVersion is called by repositories once a save succeeded.
+func (p *Plan) IncrementVersion() {
+	p.version++
+}
+
+// Apply updates plan status from a child payment event. A plan completes once
+// every installment is paid and defaults when any installment stays overdue
+// for DefaultAfterDays; completion wins over default.
+func (p *Plan) Apply(evt shared.DomainEvent) error {
+	switch e := evt.(type) {
+	case payment.PaymentPaid:
+		idx, err := p.installmentIndex(e.PaymentID)
+		if err != nil {
+			return err
+		}
+		p.installments[idx].Paid = true
+		if p.allPaid() {
+			p.status = StatusCompleted
+		}
+	case payment.OverdueAccrued:
+		idx, err := p.installmentIndex(e.PaymentID)
+		if err != nil {
+			return err
+		}
+		if p.status == StatusActive && !p.installments[idx].Paid && e.DaysOverdue >= p.defaultAfterDays {
+			p.status = StatusDefaulted
+		}
+	default:
+		return ErrUnsupportedPaymentEvent
+	}
+	p.updatedAt = evt.OccurredAt()
+	return nil
+}
+
+func (p *Plan) installmentIndex(paymentID string) (int, error) {
+	for i, inst := range p.installments {
+		if inst.PaymentID.String() == paymentID {
+			return i, nil
+		}
+	}
+	return 0, ErrUnknownInstallment
+}
+
+func (p *Plan) allPaid() bool {
+	for _, inst := range p.installments {
+		if !inst.Paid {
+			return false
+		}
+	}
+	return true
+}
+
+func dueDateFor(first time.Time, freq Frequency, i int) time.Time {
+	switch freq {
+	case FrequencyWeekly:
+		return first.AddDate(0, 0, 7*i)
+	case FrequencyBiweekly:
+		return first.AddDate(0, 0, 14*i)
+	default:
+		return addMonthsClamped(first, i)
+	}
+}
+
+// addMonthsClamped keeps month-end anchors at month end (Jan 31 → Feb 29)
+// instead of overflowing into the next month like time.AddDate.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	firstOfTarget := time.Date(y, m+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfTarget.AddDate(0, 1, -1).Day()
+	if d > lastDay {
+		d = lastDay
+	}
+	return firstOfTarget.AddDate(0, 0, d-1)
+}
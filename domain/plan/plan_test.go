@@ -0,0 +1,236 @@
+package plan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_SplitsTotalWithRemainderOnFirst(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+
+	p, payments, err := New(uid, Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 3,
+		Frequency:    FrequencyBiweekly,
+		FirstDueDate: base,
+	}, base)
+	require.NoError(t, err)
+	require.Equal(t, StatusActive, p.Status())
+	require.Len(t, payments, 3)
+
+	want := []int64{3_334, 3_333, 3_333}
+	for i, inst := range p.Installments() {
+		require.Equal(t, i+1, inst.Seq)
+		require.Equal(t, payments[i].ID(), inst.PaymentID)
+		require.True(t, inst.Amount.Amount().Equal(mustKRW(t, want[i]).Amount()))
+		require.True(t, payments[i].Amount().Amount().Equal(inst.Amount.Amount()))
+		require.Equal(t, base.AddDate(0, 0, 14*i), payments[i].DueDate())
+	}
+}
+
+func TestNew_RemainderOnLastInMinorUnits(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	total, err := money.FromMinor(10_002, money.CurrencyUSD) // $100.02
+	require.NoError(t, err)
+
+	p, _, err := New(uid, Terms{
+		Total:        total,
+		Installments: 4,
+		Frequency:    FrequencyWeekly,
+		FirstDueDate: base,
+		Remainder:    RemainderLast,
+	}, base)
+	require.NoError(t, err)
+
+	insts := p.Installments()
+	require.Equal(t, "25.00", insts[0].Amount.Amount().StringFixed(2))
+	require.Equal(t, "25.00", insts[2].Amount.Amount().StringFixed(2))
+	require.Equal(t, "25.02", insts[3].Amount.Amount().StringFixed(2))
+}
+
+func TestNew_MonthlyDueDatesClampToMonthEnd(t *testing.T) {
+	base := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+
+	_, payments, err := New(uid, Terms{
+		Total:        mustKRW(t, 40_000),
+		Installments: 4,
+		Frequency:    FrequencyMonthly,
+		FirstDueDate: base,
+	}, base)
+	require.NoError(t, err)
+
+	want := []time.Time{
+		time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+	}
+	for i, p := range payments {
+		require.Equal(t, want[i], p.DueDate())
+	}
+}
+
+func TestNew_Validation(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	terms := Terms{Total: mustKRW(t, 10_000), Installments: 4, Frequency: FrequencyWeekly, FirstDueDate: base}
+
+	_, _, err := New(user.ID{}, terms, base)
+	require.ErrorIs(t, err, ErrInvalidUserID)
+
+	bad := terms
+	bad.Installments = 0
+	_, _, err = New(uid, bad, base)
+	require.ErrorIs(t, err, ErrInvalidInstallmentCount)
+
+	bad = terms
+	bad.Frequency = "DAILY"
+	_, _, err = New(uid, bad, base)
+	require.ErrorIs(t, err, ErrInvalidFrequency)
+
+	bad = terms
+	bad.Total = mustKRW(t, 0)
+	_, _, err = New(uid, bad, base)
+	require.ErrorIs(t, err, ErrInvalidTotal)
+}
+
+func TestApply_CompletesWhenAllInstallmentsPaid(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+
+	p, payments, err := New(uid, Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 2,
+		Frequency:    FrequencyWeekly,
+		FirstDueDate: base,
+	}, base)
+	require.NoError(t, err)
+
+	for i, pay := range payments {
+		require.NoError(t, pay.Pay(pay.DueDate()))
+		for _, evt := range pay.PullEvents() {
+			require.NoError(t, p.Apply(evt))
+		}
+		if i == 0 {
+			require.Equal(t, StatusActive, p.Status())
+		}
+	}
+	require.Equal(t, StatusCompleted, p.Status())
+}
+
+func TestApply_DefaultsAfterThreshold(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+
+	p, payments, err := New(uid, Terms{
+		Total:            mustKRW(t, 10_000),
+		Installments:     2,
+		Frequency:        FrequencyWeekly,
+		FirstDueDate:     base,
+		DefaultAfterDays: 30,
+	}, base)
+	require.NoError(t, err)
+
+	first := payments[0]
+	require.NoError(t, first.AccrueInterest(base.AddDate(0, 0, 29), 10))
+	for _, evt := range first.PullEvents() {
+		require.NoError(t, p.Apply(evt))
+	}
+	require.Equal(t, StatusActive, p.Status())
+
+	require.NoError(t, first.AccrueInterest(base.AddDate(0, 0, 30), 10))
+	for _, evt := range first.PullEvents() {
+		require.NoError(t, p.Apply(evt))
+	}
+	require.Equal(t, StatusDefaulted, p.Status())
+}
+
+func TestApply_RejectsForeignPayment(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+
+	p, _, err := New(uid, Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 2,
+		Frequency:    FrequencyWeekly,
+		FirstDueDate: base,
+	}, base)
+	require.NoError(t, err)
+
+	other, err := payment.New(uid, mustKRW(t, 1_000), base, base)
+	require.NoError(t, err)
+	require.NoError(t, other.Pay(base))
+
+	for _, evt := range other.PullEvents() {
+		require.ErrorIs(t, p.Apply(evt), ErrUnknownInstallment)
+	}
+}
+
+func TestReconstitute_RejectsMismatchedInstallments(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+
+	p, _, err := New(uid, Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 2,
+		Frequency:    FrequencyWeekly,
+		FirstDueDate: base,
+	}, base)
+	require.NoError(t, err)
+
+	snapshot := Snapshot{
+		ID:               p.ID(),
+		UserID:           p.UserID(),
+		Total:            p.Total(),
+		Frequency:        p.Frequency(),
+		Remainder:        p.Remainder(),
+		DefaultAfterDays: p.DefaultAfterDays(),
+		Installments:     p.Installments(),
+		Status:           p.Status(),
+		Version:          1,
+	}
+	restored, err := Reconstitute(snapshot)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), restored.Version())
+
+	snapshot.Installments = snapshot.Installments[:1]
+	_, err = Reconstitute(snapshot)
+	require.ErrorIs(t, err, ErrInvalidInstallments)
+}
+
+func TestNew_WithIDGenerator(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := shared.NewSequentialIDGenerator(base)
+
+	p, payments, err := New(mustUserID(t, base), Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 2,
+		Frequency:    FrequencyWeekly,
+		FirstDueDate: base,
+	}, base, WithIDGenerator(shared.NewSequentialIDGenerator(base)))
+	require.NoError(t, err)
+	require.Equal(t, expected.NewID(), payments[0].ID())
+	require.Equal(t, expected.NewID(), payments[1].ID())
+	require.Equal(t, expected.NewID(), p.ID())
+}
+
+func mustUserID(t *testing.T, now time.Time) user.ID {
+	u, err := user.New("tester", now)
+	require.NoError(t, err)
+	return u.ID()
+}
+
+func mustKRW(t *testing.T, minor int64) money.Money {
+	m, err := money.FromMinor(minor, money.CurrencyKRW)
+	require.NoError(t, err)
+	return m
+}
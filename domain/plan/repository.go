@@ -0,0 +1,15 @@
+package plan
+
+import (
+	"context"
+
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+)
+
+// Repository abstracts persistence for the Plan aggregate. Save fails with
+// ErrConcurrentModification when the stored version differs from Version().
+type Repository interface {
+	Get(ctx context.Context, id shared.ID) (*Plan, error)
+	FindByPaymentID(ctx context.Context, paymentID shared.ID) (*Plan, error)
+	Save(ctx context.Context, plan *Plan) error
+}
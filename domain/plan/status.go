@@ -0,0 +1,49 @@
+package plan
+
+type Status string
+
+const (
+	StatusActive    Status = "ACTIVE"
+	StatusCompleted Status = "COMPLETED"
+	StatusDefaulted Status = "DEFAULTED"
+)
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusActive, StatusCompleted, StatusDefaulted:
+		return true
+	default:
+		return false
+	}
+}
+
+// Frequency is the spacing between installment due dates.
+type Frequency string
+
+const (
+	FrequencyWeekly   Frequency = "WEEKLY"
+	FrequencyBiweekly Frequency = "BIWEEKLY"
+	FrequencyMonthly  Frequency = "MONTHLY"
+)
+
+func (f Frequency) IsValid() bool {
+	switch f {
+	case FrequencyWeekly, FrequencyBiweekly, FrequencyMonthly:
+		return true
+	default:
+		return false
+	}
+}
+
+// RemainderPlacement decides which installment absorbs the minor units left
+// over after an even split.
+type RemainderPlacement string
+
+const (
+	RemainderFirst RemainderPlacement = "FIRST"
+	RemainderLast  RemainderPlacement = "LAST"
+)
+
+func (r RemainderPlacement) IsValid() bool {
+	return r == RemainderFirst || r == RemainderLast
+}
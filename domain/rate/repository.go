@@ -0,0 +1,12 @@
+package rate
+
+import "context"
+
+// Repository persists rate tables.
+type Repository interface {
+	// Table loads every entry for the key; an unknown key yields an empty table.
+	Table(ctx context.Context, key Key) (Table, error)
+	// Apply stores a scheduled change atomically. Implementations reject
+	// ranges that overlap stored ones with ErrOverlappingRange.
+	Apply(ctx context.Context, change Change) error
+}
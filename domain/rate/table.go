@@ -0,0 +1,180 @@
+// Package rate models effective-dated daily interest rates per product and
+// currency, as configured by pricing.
+package rate
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+)
+
+var (
+	ErrInvalidEntry     = errors.New("invalid rate table entry")
+	ErrOverlappingRange = errors.New("rate table ranges overlap")
+	ErrRateNotFound     = errors.New("no rate in effect")
+	ErrNotInFuture      = errors.New("rate change must take effect in the future")
+)
+
+// Key identifies one rate series: a product (payment.Product.Code) in a currency.
+type Key struct {
+	Product  string
+	Currency money.Currency
+}
+
+func (k Key) validate() error {
+	if strings.TrimSpace(k.Product) == "" || k.Currency == "" {
+		return ErrInvalidEntry
+	}
+	return nil
+}
+
+// Entry is a daily rate in effect over [EffectiveFrom, EffectiveTo). A nil
+// EffectiveTo leaves the range open until a later change is scheduled.
+type Entry struct {
+	ID            shared.ID
+	Key           Key
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	DailyRate     money.Rate
+	CreatedAt     time.Time
+}
+
+func (e Entry) validate() error {
+	if shared.IsZero(e.ID) || e.EffectiveFrom.IsZero() || e.DailyRate.Validate() != nil {
+		return ErrInvalidEntry
+	}
+	if e.EffectiveTo != nil && !e.EffectiveTo.After(e.EffectiveFrom) {
+		return ErrInvalidEntry
+	}
+	return e.Key.validate()
+}
+
+// Covers reports whether at falls within the entry's range.
+func (e Entry) Covers(at time.Time) bool {
+	return !at.Before(e.EffectiveFrom) && (e.EffectiveTo == nil || at.Before(*e.EffectiveTo))
+}
+
+// Overlaps reports whether both entries share at least one instant.
+func (e Entry) Overlaps(other Entry) bool {
+	startsBeforeOtherEnds := other.EffectiveTo == nil || e.EffectiveFrom.Before(*other.EffectiveTo)
+	endsAfterOtherStarts := e.EffectiveTo == nil || other.EffectiveFrom.Before(*e.EffectiveTo)
+	return startsBeforeOtherEnds && endsAfterOtherStarts
+}
+
+// Table is the series of entries for one key, ordered by EffectiveFrom with
+// no two ranges overlapping. Gaps are allowed; lookups inside them fail with
+// ErrRateNotFound.
+type Table struct {
+	key     Key
+	entries []Entry
+}
+
+// NewTable validates and orders entries, rejecting overlaps with
+// ErrOverlappingRange.
+func NewTable(key Key, entries ...Entry) (Table, error) {
+	if err := key.validate(); err != nil {
+		return Table{}, err
+	}
+	sorted := make([]Entry, len(entries))
+	for i, e := range entries {
+		if err := e.validate(); err != nil {
+			return Table{}, err
+		}
+		if e.Key != key {
+			return Table{}, ErrInvalidEntry
+		}
+		sorted[i] = cloneEntry(e)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].EffectiveFrom.Before(sorted[j].EffectiveFrom)
+	})
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].Overlaps(sorted[i]) {
+			return Table{}, ErrOverlappingRange
+		}
+	}
+	return Table{key: key, entries: sorted}, nil
+}
+
+func (t Table) Key() Key {
+	return t.key
+}
+
+// Entries returns a copy of the ordered entries.
+func (t Table) Entries() []Entry {
+	out := make([]Entry, len(t.entries))
+	for i, e := range t.entries {
+		out[i] = cloneEntry(e)
+	}
+	return out
+}
+
+// At returns the entry in effect at the given time.
+func (t Table) At(at time.Time) (Entry, error) {
+	idx := sort.Search(len(t.entries), func(i int) bool {
+		return t.entries[i].EffectiveFrom.After(at)
+	})
+	if idx == 0 || !t.entries[idx-1].Covers(at) {
+		return Entry{}, ErrRateNotFound
+	}
+	return cloneEntry(t.entries[idx-1]), nil
+}
+
+// Change is the outcome of scheduling: the new entry and, when the change
+// cuts an existing range short, that entry with its new EffectiveTo.
+type Change struct {
+	Added  Entry
+	Closed *Entry
+}
+
+// Schedule adds a rate taking effect at from. The entry in effect at that time
+// is closed there, and the new one runs until the next scheduled entry (or
+// stays open). A change at an instant where another entry already starts is
+// rejected with ErrOverlappingRange.
+func (t Table) Schedule(id shared.ID, from time.Time, dailyRate money.Rate, now time.Time) (Table, Change, error) {
+	added := Entry{ID: id, Key: t.key, EffectiveFrom: from, DailyRate: dailyRate, CreatedAt: now}
+	if err := added.validate(); err != nil {
+		return Table{}, Change{}, err
+	}
+
+	entries := t.Entries()
+	var change Change
+	for i := range entries {
+		e := &entries[i]
+		if e.EffectiveFrom.Equal(from) {
+			return Table{}, Change{}, ErrOverlappingRange
+		}
+		if e.EffectiveFrom.After(from) {
+			if added.EffectiveTo == nil || e.EffectiveFrom.Before(*added.EffectiveTo) {
+				next := e.EffectiveFrom
+				added.EffectiveTo = &next
+			}
+			continue
+		}
+		if e.Covers(from) {
+			end := from
+			e.EffectiveTo = &end
+			closed := cloneEntry(*e)
+			change.Closed = &closed
+		}
+	}
+	change.Added = cloneEntry(added)
+
+	next, err := NewTable(t.key, append(entries, added)...)
+	if err != nil {
+		return Table{}, Change{}, err
+	}
+	return next, change, nil
+}
+
+func cloneEntry(e Entry) Entry {
+	if e.EffectiveTo != nil {
+		end := *e.EffectiveTo
+		e.EffectiveTo = &end
+	}
+	return e
+}
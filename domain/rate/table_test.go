@@ -0,0 +1,90 @@
+package rate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = Key{Product: "BNPL_30", Currency: money.CurrencyKRW}
+
+func TestNewTable_RejectsOverlap(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := jan.AddDate(0, 1, 0)
+
+	_, err := NewTable(testKey,
+		entry(jan, &feb, 10),
+		entry(feb, nil, 12),
+	)
+	require.NoError(t, err)
+
+	_, err = NewTable(testKey,
+		entry(jan, nil, 10),
+		entry(feb, nil, 12),
+	)
+	require.ErrorIs(t, err, ErrOverlappingRange)
+
+	_, err = NewTable(testKey, entry(feb, &jan, 10))
+	require.ErrorIs(t, err, ErrInvalidEntry)
+}
+
+func TestTable_At(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := jan.AddDate(0, 1, 0)
+	mar := jan.AddDate(0, 2, 0)
+
+	table, err := NewTable(testKey, entry(mar, nil, 12), entry(jan, &feb, 10))
+	require.NoError(t, err)
+
+	got, err := table.At(jan.AddDate(0, 0, 10))
+	require.NoError(t, err)
+	require.True(t, got.DailyRate.Equal(money.RateFromBPS(10)))
+
+	_, err = table.At(feb) // gap until March
+	require.ErrorIs(t, err, ErrRateNotFound)
+	_, err = table.At(jan.Add(-time.Second))
+	require.ErrorIs(t, err, ErrRateNotFound)
+
+	got, err = table.At(mar.AddDate(5, 0, 0))
+	require.NoError(t, err)
+	require.True(t, got.DailyRate.Equal(money.RateFromBPS(12)))
+}
+
+func TestTable_ScheduleClosesCurrentAndStopsAtNext(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := jan.AddDate(0, 1, 0)
+	mar := jan.AddDate(0, 2, 0)
+
+	current := entry(jan, nil, 10)
+	table, err := NewTable(testKey, current)
+	require.NoError(t, err)
+
+	table, change, err := table.Schedule(shared.NewID(), mar, money.RateFromBPS(12), jan)
+	require.NoError(t, err)
+	require.Equal(t, current.ID, change.Closed.ID)
+	require.Equal(t, mar, *change.Closed.EffectiveTo)
+	require.Nil(t, change.Added.EffectiveTo)
+
+	table, change, err = table.Schedule(shared.NewID(), feb, money.RateFromBPS(11), jan)
+	require.NoError(t, err)
+	require.Equal(t, feb, *change.Closed.EffectiveTo)
+	require.Equal(t, mar, *change.Added.EffectiveTo)
+	require.Len(t, table.Entries(), 3)
+
+	_, _, err = table.Schedule(shared.NewID(), feb, money.RateFromBPS(13), jan)
+	require.ErrorIs(t, err, ErrOverlappingRange)
+}
+
+func entry(from time.Time, to *time.Time, bps int64) Entry {
+	return Entry{
+		ID:            shared.NewID(),
+		Key:           testKey,
+		EffectiveFrom: from,
+		EffectiveTo:   to,
+		DailyRate:     money.RateFromBPS(bps),
+		CreatedAt:     from,
+	}
+}
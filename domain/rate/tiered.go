@@ -0,0 +1,121 @@
+package rate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+)
+
+var (
+	ErrTierNotFound      = errors.New("risk tier not found")
+	ErrInvalidTierPolicy = errors.New("invalid tier pricing")
+)
+
+// Tier is a risk grade assigned by underwriting, e.g. "A" (lowest risk).
+type Tier string
+
+// TierLookup resolves the current risk tier of a user.
+type TierLookup interface {
+	TierOf(userID user.ID) (Tier, error)
+}
+
+// StaticTiers is a fixed user-to-tier map, useful for tests and batch jobs
+// that load tiers up front.
+type StaticTiers map[user.ID]Tier
+
+func (t StaticTiers) TierOf(userID user.ID) (Tier, error) {
+	tier, ok := t[userID]
+	if !ok {
+		return "", ErrTierNotFound
+	}
+	return tier, nil
+}
+
+// Escalation raises the daily rate once a payment is more than AfterDays
+// overdue.
+type Escalation struct {
+	AfterDays int
+	Rate      money.Rate
+}
+
+// TierPricing is the daily rate schedule of one product for one tier: Base
+// from the first overdue day, then each escalation in turn.
+type TierPricing struct {
+	Product     string
+	Tier        Tier
+	Base        money.Rate
+	Escalations []Escalation
+}
+
+type pricingKey struct {
+	product string
+	tier    Tier
+}
+
+// TieredProvider implements payment.RateResolver with risk-based pricing per
+// product and user tier. Users without a tier are priced as the fallback tier.
+type TieredProvider struct {
+	tiers    TierLookup
+	fallback Tier
+	pricing  map[pricingKey]TierPricing
+}
+
+// NewTieredProvider validates the schedules; escalations may be given in any
+// order but must not repeat a threshold.
+func NewTieredProvider(tiers TierLookup, fallback Tier, pricing ...TierPricing) (*TieredProvider, error) {
+	p := &TieredProvider{tiers: tiers, fallback: fallback, pricing: make(map[pricingKey]TierPricing)}
+	for _, tp := range pricing {
+		if tp.Product == "" || tp.Tier == "" || tp.Base.Validate() != nil {
+			return nil, ErrInvalidTierPolicy
+		}
+		key := pricingKey{product: tp.Product, tier: tp.Tier}
+		if _, dup := p.pricing[key]; dup {
+			return nil, ErrInvalidTierPolicy
+		}
+		steps := append([]Escalation(nil), tp.Escalations...)
+		sort.Slice(steps, func(i, j int) bool { return steps[i].AfterDays < steps[j].AfterDays })
+		for i, step := range steps {
+			if step.AfterDays <= 0 || step.Rate.Validate() != nil ||
+				i > 0 && steps[i-1].AfterDays == step.AfterDays {
+				return nil, ErrInvalidTierPolicy
+			}
+		}
+		tp.Escalations = steps
+		p.pricing[key] = tp
+	}
+	return p, nil
+}
+
+// ResolveDailyRate picks the tier's base rate or the highest escalation the
+// payment has passed. The source reads e.g. "tier:B" or "tier:B/after:30d".
+func (p *TieredProvider) ResolveDailyRate(q payment.RateQuery) (payment.AppliedRate, error) {
+	tier, err := p.tiers.TierOf(q.UserID)
+	if errors.Is(err, ErrTierNotFound) && p.fallback != "" {
+		tier, err = p.fallback, nil
+	}
+	if err != nil {
+		return payment.AppliedRate{}, err
+	}
+	tp, ok := p.pricing[pricingKey{product: q.Product, tier: tier}]
+	if !ok {
+		return payment.AppliedRate{}, fmt.Errorf("%w: product %q tier %q", ErrRateNotFound, q.Product, tier)
+	}
+
+	applied := payment.AppliedRate{Rate: tp.Base, Source: "tier:" + string(tier)}
+	for _, step := range tp.Escalations {
+		if q.DaysOverdue <= step.AfterDays {
+			break
+		}
+		applied = payment.AppliedRate{
+			Rate:   step.Rate,
+			Source: fmt.Sprintf("tier:%s/after:%dd", tier, step.AfterDays),
+		}
+	}
+	return applied, nil
+}
+
+var _ payment.RateResolver = (*TieredProvider)(nil)
@@ -0,0 +1,62 @@
+package rate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTieredProvider_EscalatesByDaysOverdue(t *testing.T) {
+	prime, risky, unknown := user.NewID(), user.NewID(), user.NewID()
+	provider, err := NewTieredProvider(StaticTiers{prime: "A", risky: "C"}, "B",
+		TierPricing{Product: "BNPL_30", Tier: "A", Base: money.RateFromBPS(5)},
+		TierPricing{Product: "BNPL_30", Tier: "B", Base: money.RateFromBPS(8)},
+		TierPricing{Product: "BNPL_30", Tier: "C", Base: money.RateFromBPS(10), Escalations: []Escalation{
+			{AfterDays: 60, Rate: money.RateFromBPS(20)},
+			{AfterDays: 30, Rate: money.RateFromBPS(15)},
+		}},
+	)
+	require.NoError(t, err)
+
+	query := func(id user.ID, days int) payment.AppliedRate {
+		t.Helper()
+		applied, err := provider.ResolveDailyRate(payment.RateQuery{
+			UserID:      id,
+			Product:     "BNPL_30",
+			Currency:    money.CurrencyKRW,
+			Date:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			DaysOverdue: days,
+		})
+		require.NoError(t, err)
+		return applied
+	}
+
+	require.Equal(t, payment.AppliedRate{Rate: money.RateFromBPS(5), Source: "tier:A"}, query(prime, 90))
+	require.Equal(t, payment.AppliedRate{Rate: money.RateFromBPS(8), Source: "tier:B"}, query(unknown, 1))
+	require.Equal(t, payment.AppliedRate{Rate: money.RateFromBPS(10), Source: "tier:C"}, query(risky, 30))
+	require.Equal(t, payment.AppliedRate{Rate: money.RateFromBPS(15), Source: "tier:C/after:30d"}, query(risky, 31))
+	require.Equal(t, payment.AppliedRate{Rate: money.RateFromBPS(20), Source: "tier:C/after:60d"}, query(risky, 61))
+
+	_, err = provider.ResolveDailyRate(payment.RateQuery{UserID: prime, Product: "OTHER", DaysOverdue: 1})
+	require.ErrorIs(t, err, ErrRateNotFound)
+}
+
+func TestNewTieredProvider_Validation(t *testing.T) {
+	_, err := NewTieredProvider(StaticTiers{}, "",
+		TierPricing{Product: "BNPL_30", Tier: "A", Base: money.RateFromBPS(5), Escalations: []Escalation{
+			{AfterDays: 30, Rate: money.RateFromBPS(10)},
+			{AfterDays: 30, Rate: money.RateFromBPS(12)},
+		}},
+	)
+	require.ErrorIs(t, err, ErrInvalidTierPolicy)
+
+	provider, err := NewTieredProvider(StaticTiers{}, "",
+		TierPricing{Product: "BNPL_30", Tier: "A", Base: money.RateFromBPS(5)})
+	require.NoError(t, err)
+	_, err = provider.ResolveDailyRate(payment.RateQuery{UserID: user.NewID(), Product: "BNPL_30", DaysOverdue: 1})
+	require.ErrorIs(t, err, ErrTierNotFound)
+}
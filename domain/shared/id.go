@@ -1,13 +1,25 @@
 package shared
 
-import "github.com/oklog/ulid/v2"
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/oklog/ulid/v2"
+)
+
+var ErrInvalidID = errors.New("invalid id")
 
 type ID ulid.ULID
 
 var zero ulid.ULID
 
+// NewID issues an ID from the process-wide monotonic generator.
 func NewID() ID {
-	return ID(ulid.Make())
+	return defaultIDs.NewID()
 }
 
 func IsZero(id ID) bool {
@@ -18,7 +30,7 @@ func IsZero(id ID) bool {
 func ParseID(s string) (ID, error) {
 	u, err := ulid.Parse(s)
 	if err != nil {
-		return ID{}, err
+		return ID{}, fmt.Errorf("%w: %w", ErrInvalidID, err)
 	}
 	return ID(u), nil
 }
@@ -27,3 +39,72 @@ func ParseID(s string) (ID, error) {
 func (id ID) String() string {
 	return ulid.ULID(id).String()
 }
+
+// MarshalJSON encodes the ID as its 26-character string; the zero ID is null.
+func (id ID) MarshalJSON() ([]byte, error) {
+	if IsZero(id) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(id.String())
+}
+
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*id = ID{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidID, err)
+	}
+	return id.UnmarshalText([]byte(s))
+}
+
+// MarshalText encodes the ID as its string form, e.g. for map keys and query
+// parameters. The zero ID is rejected so it never leaks into identifiers.
+func (id ID) MarshalText() ([]byte, error) {
+	if IsZero(id) {
+		return nil, ErrInvalidID
+	}
+	return []byte(id.String()), nil
+}
+
+func (id *ID) UnmarshalText(text []byte) error {
+	parsed, err := ParseID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// Value stores the ID in a CHAR(26) column; the zero ID is NULL.
+func (id ID) Value() (driver.Value, error) {
+	if IsZero(id) {
+		return nil, nil
+	}
+	return id.String(), nil
+}
+
+func (id *ID) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*id = ID{}
+		return nil
+	case string:
+		return id.UnmarshalText([]byte(v))
+	case []byte:
+		return id.UnmarshalText(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidID, src)
+	}
+}
+
+var (
+	_ json.Marshaler           = ID{}
+	_ json.Unmarshaler         = (*ID)(nil)
+	_ encoding.TextMarshaler   = ID{}
+	_ encoding.TextUnmarshaler = (*ID)(nil)
+	_ driver.Valuer            = ID{}
+	_ sql.Scanner              = (*ID)(nil)
+)
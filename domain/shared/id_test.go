@@ -0,0 +1,52 @@
+package shared
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIDJSONRoundTrip(t *testing.T) {
+	id := NewID()
+
+	data, err := json.Marshal(map[string]ID{"id": id})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"`+id.String()+`"}`, string(data))
+
+	var decoded map[string]ID
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, id, decoded["id"])
+
+	data, err = json.Marshal(struct{ ID ID }{})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ID":null}`, string(data))
+
+	var bad ID
+	require.ErrorIs(t, json.Unmarshal([]byte(`"not-a-ulid"`), &bad), ErrInvalidID)
+	require.ErrorIs(t, json.Unmarshal([]byte(`42`), &bad), ErrInvalidID)
+}
+
+func TestIDSQLRoundTrip(t *testing.T) {
+	id := NewID()
+
+	v, err := id.Value()
+	require.NoError(t, err)
+	require.Len(t, v, 26) // CHAR(26)
+
+	var scanned ID
+	require.NoError(t, scanned.Scan(v))
+	require.Equal(t, id, scanned)
+	require.NoError(t, scanned.Scan([]byte(id.String())))
+	require.Equal(t, id, scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	require.True(t, IsZero(scanned))
+	v, err = scanned.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	require.ErrorIs(t, scanned.Scan(int64(1)), ErrInvalidID)
+	_, err = ID{}.MarshalText()
+	require.ErrorIs(t, err, ErrInvalidID)
+}
@@ -0,0 +1,92 @@
+package shared
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// IDGenerator issues new IDs. Aggregates and adapters take one so tests can
+// predict IDs instead of relying on the process-wide generator.
+type IDGenerator interface {
+	NewID() ID
+}
+
+var defaultIDs = NewMonotonicIDGenerator()
+
+// DefaultIDGenerator is the process-wide generator behind NewID.
+func DefaultIDGenerator() IDGenerator {
+	return defaultIDs
+}
+
+// MonotonicIDGenerator issues ULIDs whose entropy increases within the same
+// millisecond, so IDs sort in issue order even when created back to back. A
+// clock stepping backwards never produces an ID below the last one. It is safe
+// for concurrent use.
+type MonotonicIDGenerator struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	entropy *ulid.MonotonicEntropy
+	lastMS  uint64
+}
+
+func NewMonotonicIDGenerator() *MonotonicIDGenerator {
+	return &MonotonicIDGenerator{
+		now:     time.Now,
+		entropy: ulid.Monotonic(rand.Reader, 0),
+	}
+}
+
+func (g *MonotonicIDGenerator) NewID() ID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := max(ulid.Timestamp(g.now()), g.lastMS)
+	for {
+		id, err := ulid.New(ms, g.entropy)
+		if err == nil {
+			g.lastMS = ms
+			return ID(id)
+		}
+		if !errors.Is(err, ulid.ErrMonotonicOverflow) {
+			panic(err)
+		}
+		// Entropy exhausted for this millisecond; borrow the next one.
+		ms++
+	}
+}
+
+// SequentialIDGenerator is a deterministic fake for tests: every ID carries the
+// start timestamp and a counter (1, 2, 3, ...) as entropy, so the n-th ID is
+// always the same and IDs sort in issue order.
+type SequentialIDGenerator struct {
+	mu sync.Mutex
+	ms uint64
+	n  uint64
+}
+
+func NewSequentialIDGenerator(start time.Time) *SequentialIDGenerator {
+	return &SequentialIDGenerator{ms: ulid.Timestamp(start)}
+}
+
+func (g *SequentialIDGenerator) NewID() ID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.n++
+	var entropy [10]byte
+	binary.BigEndian.PutUint64(entropy[2:], g.n)
+	var id ulid.ULID
+	_ = id.SetTime(g.ms)
+	_ = id.SetEntropy(entropy[:])
+	return ID(id)
+}
+
+var (
+	_ IDGenerator = (*MonotonicIDGenerator)(nil)
+	_ IDGenerator = (*SequentialIDGenerator)(nil)
+)
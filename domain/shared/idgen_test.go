@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonotonicIDGenerator_OrdersWithinMillisecond(t *testing.T) {
+	fixed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	gen := NewMonotonicIDGenerator()
+	gen.now = func() time.Time { return fixed }
+
+	prev := gen.NewID()
+	for i := 0; i < 1_000; i++ {
+		next := gen.NewID()
+		require.Less(t, prev.String(), next.String())
+		prev = next
+	}
+
+	gen.now = func() time.Time { return fixed.Add(-time.Second) }
+	require.Less(t, prev.String(), gen.NewID().String())
+}
+
+func TestSequentialIDGenerator_IsDeterministic(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := NewSequentialIDGenerator(start)
+	b := NewSequentialIDGenerator(start)
+
+	first := a.NewID()
+	require.Equal(t, first, b.NewID())
+	require.Equal(t, "01HK153X000000000000000001", first.String())
+	require.Equal(t, "01HK153X000000000000000002", a.NewID().String())
+}
@@ -0,0 +1,115 @@
+package user
+
+import (
+	"errors"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrInvalidEmail       = errors.New("invalid email")
+	ErrInvalidPhone       = errors.New("invalid phone number")
+	ErrInvalidLanguage    = errors.New("invalid preferred language")
+	ErrInvalidChannel     = errors.New("invalid notification channel")
+	ErrChannelUnreachable = errors.New("opted-in channel has no contact detail")
+)
+
+var (
+	// E.164: leading +, country code, at most 15 digits in total.
+	phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	// ISO 639-1 language with an optional ISO 3166 region, e.g. "ko" or "ko-KR".
+	languagePattern = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+)
+
+// Contact holds the personal data used to reach a user. Both fields are
+// optional; an empty Contact means the user cannot be notified.
+type Contact struct {
+	Email string
+	Phone string
+}
+
+// NewContact normalizes and validates contact details. Phone numbers may be
+// written with spaces, dashes or parentheses but must be E.164 once stripped.
+func NewContact(email, phone string) (Contact, error) {
+	var c Contact
+	if email = strings.TrimSpace(email); email != "" {
+		addr, err := mail.ParseAddress(email)
+		if err != nil || addr.Name != "" || addr.Address != email {
+			return Contact{}, ErrInvalidEmail
+		}
+		c.Email = strings.ToLower(addr.Address)
+	}
+	if phone = strings.TrimSpace(phone); phone != "" {
+		normalized := strings.Map(func(r rune) rune {
+			switch r {
+			case ' ', '-', '(', ')', '.':
+				return -1
+			}
+			return r
+		}, phone)
+		if !phonePattern.MatchString(normalized) {
+			return Contact{}, ErrInvalidPhone
+		}
+		c.Phone = normalized
+	}
+	return c, nil
+}
+
+func (c Contact) IsZero() bool {
+	return c.Email == "" && c.Phone == ""
+}
+
+// Channel is a way of delivering notifications.
+type Channel string
+
+const (
+	ChannelEmail Channel = "EMAIL"
+	ChannelSMS   Channel = "SMS"
+)
+
+func (c Channel) IsValid() bool {
+	return c == ChannelEmail || c == ChannelSMS
+}
+
+// Preferences captures how the user wants to be notified.
+type Preferences struct {
+	// Language is the preferred language tag, e.g. "ko-KR"; empty uses the product default.
+	Language string
+	OptIns   []Channel
+}
+
+// NewPreferences validates the language tag and channels, dropping duplicates.
+func NewPreferences(language string, optIns ...Channel) (Preferences, error) {
+	language = strings.TrimSpace(language)
+	if language != "" && !languagePattern.MatchString(language) {
+		return Preferences{}, ErrInvalidLanguage
+	}
+	channels := make([]Channel, 0, len(optIns))
+	for _, ch := range optIns {
+		if !ch.IsValid() {
+			return Preferences{}, ErrInvalidChannel
+		}
+		if !slices.Contains(channels, ch) {
+			channels = append(channels, ch)
+		}
+	}
+	slices.Sort(channels)
+	return Preferences{Language: language, OptIns: channels}, nil
+}
+
+// Allows reports whether the user opted into the channel.
+func (p Preferences) Allows(ch Channel) bool {
+	return slices.Contains(p.OptIns, ch)
+}
+
+// reachable checks every opted-in channel has the detail it needs.
+func (p Preferences) reachable(c Contact) error {
+	for _, ch := range p.OptIns {
+		if ch == ChannelEmail && c.Email == "" || ch == ChannelSMS && c.Phone == "" {
+			return ErrChannelUnreachable
+		}
+	}
+	return nil
+}
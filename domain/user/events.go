@@ -0,0 +1,65 @@
+package user
+
+import (
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+)
+
+const (
+	EventUserStatusChanged = "user.status_changed"
+	EventUserAnonymized    = "user.anonymized"
+)
+
+// StatusChanged is raised on every lifecycle transition.
+type StatusChanged struct {
+	UserID         string    `json:"user_id"`
+	From           Status    `json:"from"`
+	To             Status    `json:"to"`
+	Reason         string    `json:"reason,omitempty"`
+	OccurredAtTime time.Time `json:"occurred_at"`
+}
+
+func (e StatusChanged) EventType() string {
+	return EventUserStatusChanged
+}
+
+func (e StatusChanged) AggregateType() string {
+	return "user"
+}
+
+func (e StatusChanged) AggregateID() string {
+	return e.UserID
+}
+
+func (e StatusChanged) OccurredAt() time.Time {
+	return e.OccurredAtTime
+}
+
+// UserAnonymized is raised once a user's personal data has been erased. It carries
+// no PII so downstream systems can purge their own copies by ID.
+type UserAnonymized struct {
+	UserID         string    `json:"user_id"`
+	OccurredAtTime time.Time `json:"occurred_at"`
+}
+
+func (e UserAnonymized) EventType() string {
+	return EventUserAnonymized
+}
+
+func (e UserAnonymized) AggregateType() string {
+	return "user"
+}
+
+func (e UserAnonymized) AggregateID() string {
+	return e.UserID
+}
+
+func (e UserAnonymized) OccurredAt() time.Time {
+	return e.OccurredAtTime
+}
+
+var (
+	_ shared.DomainEvent = StatusChanged{}
+	_ shared.DomainEvent = UserAnonymized{}
+)
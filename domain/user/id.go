@@ -0,0 +1,111 @@
+package user
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+)
+
+type ID struct {
+	value shared.ID
+}
+
+func NewID() ID {
+	return ID{value: shared.NewID()}
+}
+
+// IDFrom wraps an existing shared ID, e.g. when loading from storage.
+func IDFrom(value shared.ID) ID {
+	return ID{value: value}
+}
+
+// ParseID parses the 26-character ULID form, e.g. from a URL path. The zero
+// ULID is rejected with ErrInvalidUserID.
+func ParseID(s string) (ID, error) {
+	value, err := shared.ParseID(s)
+	if err != nil {
+		return ID{}, errors.Join(ErrInvalidUserID, err)
+	}
+	if shared.IsZero(value) {
+		return ID{}, ErrInvalidUserID
+	}
+	return ID{value: value}, nil
+}
+
+// Shared unwraps the underlying shared ID.
+func (id ID) Shared() shared.ID {
+	return id.value
+}
+
+func (id ID) String() string {
+	return id.value.String()
+}
+
+func (id ID) IsZero() bool {
+	return shared.IsZero(id.value)
+}
+
+func (id ID) MarshalJSON() ([]byte, error) {
+	return id.value.MarshalJSON()
+}
+
+// UnmarshalJSON accepts null as the zero ID but, like ParseID, rejects the
+// zero ULID spelled out.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*id = ID{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Join(ErrInvalidUserID, err)
+	}
+	return id.UnmarshalText([]byte(s))
+}
+
+func (id ID) MarshalText() ([]byte, error) {
+	return id.value.MarshalText()
+}
+
+func (id *ID) UnmarshalText(text []byte) error {
+	parsed, err := ParseID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// Value stores the ID in the users.id / user_id CHAR(26) columns.
+func (id ID) Value() (driver.Value, error) {
+	return id.value.Value()
+}
+
+// Scan reads NULL as the zero ID and otherwise applies ParseID's checks.
+func (id *ID) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*id = ID{}
+		return nil
+	case string:
+		return id.UnmarshalText([]byte(v))
+	case []byte:
+		return id.UnmarshalText(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidUserID, src)
+	}
+}
+
+var (
+	_ json.Marshaler           = ID{}
+	_ json.Unmarshaler         = (*ID)(nil)
+	_ encoding.TextMarshaler   = ID{}
+	_ encoding.TextUnmarshaler = (*ID)(nil)
+	_ driver.Valuer            = ID{}
+	_ sql.Scanner              = (*ID)(nil)
+)
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseID(t *testing.T) {
+	id := NewID()
+
+	parsed, err := ParseID(id.String())
+	require.NoError(t, err)
+	require.Equal(t, id, parsed)
+
+	_, err = ParseID("01H")
+	require.ErrorIs(t, err, ErrInvalidUserID)
+	_, err = ParseID("00000000000000000000000000")
+	require.ErrorIs(t, err, ErrInvalidUserID)
+}
+
+func TestIDEncodingRoundTrip(t *testing.T) {
+	id := NewID()
+
+	data, err := json.Marshal(struct {
+		UserID ID `json:"user_id"`
+	}{id})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"user_id":"`+id.String()+`"}`, string(data))
+
+	var decoded struct {
+		UserID ID `json:"user_id"`
+	}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, id, decoded.UserID)
+
+	text, err := id.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, id.String(), string(text))
+
+	v, err := id.Value()
+	require.NoError(t, err)
+	var scanned ID
+	require.NoError(t, scanned.Scan(v))
+	require.Equal(t, id, scanned)
+}
+
+func TestIDDecodingRejectsZeroULID(t *testing.T) {
+	const zeroULID = "00000000000000000000000000"
+	var id ID
+
+	require.ErrorIs(t, id.UnmarshalText([]byte(zeroULID)), ErrInvalidUserID)
+	require.ErrorIs(t, json.Unmarshal([]byte(`"`+zeroULID+`"`), &id), ErrInvalidUserID)
+	require.ErrorIs(t, id.Scan(zeroULID), ErrInvalidUserID)
+	require.ErrorIs(t, id.Scan([]byte(zeroULID)), ErrInvalidUserID)
+	require.ErrorIs(t, id.Scan(int64(1)), ErrInvalidUserID)
+	require.ErrorIs(t, id.UnmarshalText([]byte("01H")), ErrInvalidUserID)
+
+	require.NoError(t, json.Unmarshal([]byte(`null`), &id))
+	require.True(t, id.IsZero())
+	require.NoError(t, id.Scan(nil))
+	require.True(t, id.IsZero())
+}
@@ -0,0 +1,12 @@
+package user
+
+import "context"
+
+// Repository abstracts persistence for the User aggregate. Save fails with
+// ErrConcurrentModification when the stored version differs from Version()
+// and with ErrExternalRefTaken when another user holds the same ExternalRef.
+type Repository interface {
+	Get(ctx context.Context, id ID) (*User, error)
+	FindByExternalRef(ctx context.Context, ref string) (*User, error)
+	Save(ctx context.Context, u *User) error
+}
@@ -0,0 +1,49 @@
+package user
+
+// Status is the account lifecycle state of a user.
+type Status string
+
+const (
+	StatusPendingKYC Status = "PENDING_KYC"
+	StatusActive     Status = "ACTIVE"
+	StatusSuspended  Status = "SUSPENDED"
+	StatusClosed     Status = "CLOSED"
+)
+
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusPendingKYC, StatusActive, StatusSuspended, StatusClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanBorrow reports whether new payments or plans may be created.
+func (s Status) CanBorrow() bool {
+	return s == StatusActive
+}
+
+// AccruesInterest reports whether overdue interest keeps compounding. Closed
+// accounts (e.g. deceased or written off) are frozen; suspended accounts still accrue.
+func (s Status) AccruesInterest() bool {
+	return s != StatusClosed
+}
+
+// canTransition lists the allowed moves:
+//
+//	PENDING_KYC → ACTIVE | CLOSED
+//	ACTIVE      → SUSPENDED | CLOSED
+//	SUSPENDED   → ACTIVE | CLOSED
+func (s Status) canTransition(to Status) bool {
+	switch s {
+	case StatusPendingKYC:
+		return to == StatusActive || to == StatusClosed
+	case StatusActive:
+		return to == StatusSuspended || to == StatusClosed
+	case StatusSuspended:
+		return to == StatusActive || to == StatusClosed
+	default:
+		return false
+	}
+}
@@ -2,41 +2,78 @@ package user
 
 import (
 	"errors"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/jaeyoung0509/compound-interest/domain/money"
 	"github.com/jaeyoung0509/compound-interest/domain/shared"
 )
 
 var (
-	ErrInvalidUserName = errors.New("invalid user name")
-	ErrInvalidUserID   = errors.New("invalid user id")
+	ErrInvalidUserName        = errors.New("invalid user name")
+	ErrInvalidUserID          = errors.New("invalid user id")
+	ErrInvalidExternalRef     = errors.New("invalid external reference")
+	ErrUserNotFound           = errors.New("user not found")
+	ErrExternalRefTaken       = errors.New("external reference already linked to another user")
+	ErrConcurrentModification = errors.New("user modified concurrently")
+	ErrInvalidCreditLimit     = errors.New("invalid credit limit")
+	ErrInvalidStatus          = errors.New("invalid user status")
+	ErrInvalidTransition      = errors.New("invalid user status transition")
+	ErrUserNotActive          = errors.New("user is not active")
+	ErrUserAnonymized         = errors.New("user is anonymized")
+	ErrOutstandingBalance     = errors.New("user has outstanding balance")
 )
 
-type ID struct {
-	value shared.ID
-}
+const maxExternalRefLength = 100
+
+// AnonymizedName replaces the display name once personal data is erased.
+const AnonymizedName = "anonymized"
 
-func NewID() ID {
-	return ID{value: shared.NewID()}
+// User carries minimal identity info with an aggregate-scoped ID.
+type User struct {
+	id           ID
+	name         string
+	externalRef  string
+	creditLimit  money.Money
+	status       Status
+	contact      Contact
+	preferences  Preferences
+	anonymizedAt *time.Time
+	events       []shared.DomainEvent
+	createdAt    time.Time
+	updatedAt    time.Time
+	version      int64
 }
 
-func (id ID) Value() shared.ID {
-	return id.value
+// Option customizes a User at creation.
+type Option func(*User)
+
+// WithExternalRef links the user to an identifier in an upstream system
+// (merchant customer ID, KYC reference). It must be unique across users.
+func WithExternalRef(ref string) Option {
+	return func(u *User) {
+		u.externalRef = strings.TrimSpace(ref)
+	}
 }
 
-func (id ID) IsZero() bool {
-	return shared.IsZero(id.value)
+// WithIDGenerator issues the user ID from gen instead of the process-wide generator.
+func WithIDGenerator(gen shared.IDGenerator) Option {
+	return func(u *User) {
+		if gen != nil {
+			u.id = IDFrom(gen.NewID())
+		}
+	}
 }
 
-// User carries minimal identity info with an aggregate-scoped ID.
-type User struct {
-	id        ID
-	name      string
-	createdAt time.Time
+// WithCreditLimit sets the initial BNPL credit limit.
+func WithCreditLimit(limit money.Money) Option {
+	return func(u *User) {
+		u.creditLimit = limit
+	}
 }
 
-func New(name string, now time.Time) (*User, error) {
+func New(name string, now time.Time, opts ...Option) (*User, error) {
 	name = strings.TrimSpace(name)
 	if name == "" {
 		return nil, ErrInvalidUserName
@@ -44,25 +81,78 @@ func New(name string, now time.Time) (*User, error) {
 	if now.IsZero() {
 		now = time.Now()
 	}
-	return &User{
-		id:        NewID(),
+	u := &User{
 		name:      name,
+		status:    StatusPendingKYC,
 		createdAt: now,
-	}, nil
+		updatedAt: now,
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	if u.id.IsZero() {
+		u.id = NewID()
+	}
+	if len(u.externalRef) > maxExternalRefLength {
+		return nil, ErrInvalidExternalRef
+	}
+	if u.creditLimit.IsNegative() {
+		return nil, ErrInvalidCreditLimit
+	}
+	return u, nil
 }
 
-func Reconstitute(id ID, name string, createdAt time.Time) (*User, error) {
-	name = strings.TrimSpace(name)
-	if id.IsZero() {
+// Snapshot carries persisted state used to rebuild a User.
+type Snapshot struct {
+	ID          ID
+	Name        string
+	ExternalRef string
+	CreditLimit money.Money
+	Status      Status
+	Contact     Contact
+	Preferences Preferences
+	// AnonymizedAt is set once personal data was erased.
+	AnonymizedAt *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Version      int64
+}
+
+// Reconstitute rebuilds a User from storage.
+func Reconstitute(s Snapshot) (*User, error) {
+	name := strings.TrimSpace(s.Name)
+	if s.ID.IsZero() {
 		return nil, ErrInvalidUserID
 	}
 	if name == "" {
 		return nil, ErrInvalidUserName
 	}
-	if createdAt.IsZero() {
+	if s.CreatedAt.IsZero() {
 		return nil, ErrInvalidUserID
 	}
-	return &User{id: id, name: name, createdAt: createdAt}, nil
+	if s.CreditLimit.IsNegative() {
+		return nil, ErrInvalidCreditLimit
+	}
+	if !s.Status.IsValid() {
+		return nil, ErrInvalidStatus
+	}
+	updatedAt := s.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = s.CreatedAt
+	}
+	return &User{
+		id:           s.ID,
+		name:         name,
+		externalRef:  s.ExternalRef,
+		creditLimit:  s.CreditLimit,
+		status:       s.Status,
+		contact:      s.Contact,
+		preferences:  clonePreferences(s.Preferences),
+		anonymizedAt: cloneTime(s.AnonymizedAt),
+		createdAt:    s.CreatedAt,
+		updatedAt:    updatedAt,
+		version:      s.Version,
+	}, nil
 }
 
 func (u *User) ID() ID {
@@ -73,6 +163,192 @@ func (u *User) Name() string {
 	return u.name
 }
 
+// ExternalRef is the upstream identifier, empty when the user is not linked.
+func (u *User) ExternalRef() string {
+	return u.externalRef
+}
+
+// CreditLimit is the maximum outstanding BNPL exposure; the zero Money (no
+// currency) means no credit has been granted.
+func (u *User) CreditLimit() money.Money {
+	return u.creditLimit
+}
+
+func (u *User) Status() Status {
+	return u.status
+}
+
+func (u *User) Contact() Contact {
+	return u.contact
+}
+
+func (u *User) Preferences() Preferences {
+	return clonePreferences(u.preferences)
+}
+
+// AnonymizedAt is when personal data was erased, nil while the user is identifiable.
+func (u *User) AnonymizedAt() *time.Time {
+	return cloneTime(u.anonymizedAt)
+}
+
+func (u *User) IsAnonymized() bool {
+	return u.anonymizedAt != nil
+}
+
 func (u *User) CreatedAt() time.Time {
 	return u.createdAt
 }
+
+func (u *User) UpdatedAt() time.Time {
+	return u.updatedAt
+}
+
+// Version is the persisted revision the aggregate was loaded at; zero means never saved.
+func (u *User) Version() int64 {
+	return u.version
+}
+
+// IncrementVersion is called by repositories once a save succeeded.
+func (u *User) IncrementVersion() {
+	u.version++
+}
+
+// Rename changes the display name.
+func (u *User) Rename(name string, now time.Time) error {
+	if u.IsAnonymized() {
+		return ErrUserAnonymized
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrInvalidUserName
+	}
+	u.name = name
+	u.updatedAt = now
+	return nil
+}
+
+// SetCreditLimit replaces the credit limit, e.g. after an underwriting review.
+func (u *User) SetCreditLimit(limit money.Money, now time.Time) error {
+	if limit.Currency() == "" || limit.IsNegative() {
+		return ErrInvalidCreditLimit
+	}
+	u.creditLimit = limit
+	u.updatedAt = now
+	return nil
+}
+
+// UpdateContact replaces the contact details; build c with NewContact. Removing
+// a detail that an opted-in channel relies on fails with ErrChannelUnreachable.
+func (u *User) UpdateContact(c Contact, now time.Time) error {
+	if u.IsAnonymized() {
+		return ErrUserAnonymized
+	}
+	if err := u.preferences.reachable(c); err != nil {
+		return err
+	}
+	u.contact = c
+	u.updatedAt = now
+	return nil
+}
+
+// UpdatePreferences replaces language and opt-ins; build p with NewPreferences.
+func (u *User) UpdatePreferences(p Preferences, now time.Time) error {
+	if u.IsAnonymized() {
+		return ErrUserAnonymized
+	}
+	if err := p.reachable(u.contact); err != nil {
+		return err
+	}
+	u.preferences = clonePreferences(p)
+	u.updatedAt = now
+	return nil
+}
+
+func clonePreferences(p Preferences) Preferences {
+	p.OptIns = slices.Clone(p.OptIns)
+	return p
+}
+
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := *t
+	return &v
+}
+
+// VerifyKYC activates a user whose identity check passed.
+func (u *User) VerifyKYC(now time.Time) error {
+	if u.status != StatusPendingKYC {
+		return ErrInvalidTransition
+	}
+	return u.transition(StatusActive, "kyc verified", now)
+}
+
+// Suspend stops new lending, e.g. on suspected fraud.
+func (u *User) Suspend(reason string, now time.Time) error {
+	return u.transition(StatusSuspended, reason, now)
+}
+
+// Reinstate reactivates a suspended user.
+func (u *User) Reinstate(reason string, now time.Time) error {
+	if u.status != StatusSuspended {
+		return ErrInvalidTransition
+	}
+	return u.transition(StatusActive, reason, now)
+}
+
+// Close ends the relationship permanently (customer request, deceased,
+// written off); overdue interest stops accruing.
+func (u *User) Close(reason string, now time.Time) error {
+	return u.transition(StatusClosed, reason, now)
+}
+
+// Anonymize erases personal data (name, external reference, contact details,
+// preferences) while keeping the ID so payments and ledgers stay intact. The
+// account is closed first if it is not already. Callers must make sure no
+// balance is outstanding; see ErrOutstandingBalance.
+func (u *User) Anonymize(now time.Time) error {
+	if u.IsAnonymized() {
+		return ErrUserAnonymized
+	}
+	if u.status != StatusClosed {
+		if err := u.transition(StatusClosed, "anonymized", now); err != nil {
+			return err
+		}
+	}
+	u.name = AnonymizedName
+	u.externalRef = ""
+	u.contact = Contact{}
+	u.preferences = Preferences{}
+	u.anonymizedAt = &now
+	u.updatedAt = now
+	u.events = append(u.events, UserAnonymized{
+		UserID:         u.id.String(),
+		OccurredAtTime: now,
+	})
+	return nil
+}
+
+// PullEvents returns the domain events raised since the last call and clears them.
+func (u *User) PullEvents() []shared.DomainEvent {
+	events := u.events
+	u.events = nil
+	return events
+}
+
+func (u *User) transition(to Status, reason string, now time.Time) error {
+	if !u.status.canTransition(to) {
+		return ErrInvalidTransition
+	}
+	u.events = append(u.events, StatusChanged{
+		UserID:         u.id.String(),
+		From:           u.status,
+		To:             to,
+		Reason:         reason,
+		OccurredAtTime: now,
+	})
+	u.status = to
+	u.updatedAt = now
+	return nil
+}
@@ -0,0 +1,134 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusLifecycle(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	u, err := New("tester", now)
+	require.NoError(t, err)
+	require.Equal(t, StatusPendingKYC, u.Status())
+	require.False(t, u.Status().CanBorrow())
+
+	require.ErrorIs(t, u.Reinstate("", now), ErrInvalidTransition)
+	require.NoError(t, u.VerifyKYC(now))
+	require.True(t, u.Status().CanBorrow())
+	require.ErrorIs(t, u.VerifyKYC(now), ErrInvalidTransition)
+
+	later := now.Add(time.Hour)
+	require.NoError(t, u.Suspend("fraud review", later))
+	require.Equal(t, later, u.UpdatedAt())
+	require.False(t, u.Status().CanBorrow())
+	require.True(t, u.Status().AccruesInterest())
+
+	require.NoError(t, u.Reinstate("cleared", later))
+	require.NoError(t, u.Close("deceased", later))
+	require.False(t, u.Status().AccruesInterest())
+	require.ErrorIs(t, u.Suspend("", later), ErrInvalidTransition)
+	require.ErrorIs(t, u.Close("", later), ErrInvalidTransition)
+
+	events := u.PullEvents()
+	require.Len(t, events, 4)
+	last := events[3].(StatusChanged)
+	require.Equal(t, StatusActive, last.From)
+	require.Equal(t, StatusClosed, last.To)
+	require.Equal(t, "deceased", last.Reason)
+	require.Equal(t, u.ID().String(), last.AggregateID())
+	require.Empty(t, u.PullEvents())
+}
+
+func TestReconstitute_RequiresValidStatus(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := Snapshot{ID: NewID(), Name: "tester", Status: StatusActive, CreatedAt: now, Version: 1}
+
+	u, err := Reconstitute(snapshot)
+	require.NoError(t, err)
+	require.Equal(t, StatusActive, u.Status())
+	require.Equal(t, now, u.UpdatedAt())
+
+	snapshot.Status = "DECEASED"
+	_, err = Reconstitute(snapshot)
+	require.ErrorIs(t, err, ErrInvalidStatus)
+}
+
+func TestNewContact_Normalizes(t *testing.T) {
+	c, err := NewContact(" Kim@Example.COM ", "+82 10-1234-5678")
+	require.NoError(t, err)
+	require.Equal(t, "kim@example.com", c.Email)
+	require.Equal(t, "+821012345678", c.Phone)
+
+	empty, err := NewContact("", "")
+	require.NoError(t, err)
+	require.True(t, empty.IsZero())
+
+	_, err = NewContact("Kim <kim@example.com>", "")
+	require.ErrorIs(t, err, ErrInvalidEmail)
+	_, err = NewContact("", "010-1234-5678")
+	require.ErrorIs(t, err, ErrInvalidPhone)
+}
+
+func TestNewPreferences_ValidatesAndDedups(t *testing.T) {
+	p, err := NewPreferences("ko-KR", ChannelSMS, ChannelEmail, ChannelSMS)
+	require.NoError(t, err)
+	require.Equal(t, []Channel{ChannelEmail, ChannelSMS}, p.OptIns)
+	require.True(t, p.Allows(ChannelSMS))
+
+	_, err = NewPreferences("korean")
+	require.ErrorIs(t, err, ErrInvalidLanguage)
+	_, err = NewPreferences("", Channel("PUSH"))
+	require.ErrorIs(t, err, ErrInvalidChannel)
+}
+
+func TestPreferences_RequireReachableContact(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	u, err := New("tester", now)
+	require.NoError(t, err)
+
+	sms, err := NewPreferences("ko", ChannelSMS)
+	require.NoError(t, err)
+	require.ErrorIs(t, u.UpdatePreferences(sms, now), ErrChannelUnreachable)
+
+	contact, err := NewContact("", "+821012345678")
+	require.NoError(t, err)
+	require.NoError(t, u.UpdateContact(contact, now))
+	require.NoError(t, u.UpdatePreferences(sms, now))
+
+	require.ErrorIs(t, u.UpdateContact(Contact{Email: "kim@example.com"}, now), ErrChannelUnreachable)
+	require.Equal(t, contact, u.Contact())
+}
+
+func TestAnonymize_KeepsIDAndBlocksEdits(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	u, err := New("tester", now, WithExternalRef("kyc-1"))
+	require.NoError(t, err)
+	require.NoError(t, u.Close("customer request", now))
+	u.PullEvents()
+
+	later := now.Add(time.Hour)
+	require.NoError(t, u.Anonymize(later))
+	require.True(t, u.IsAnonymized())
+	require.Equal(t, later, *u.AnonymizedAt())
+	require.Equal(t, AnonymizedName, u.Name())
+	require.Empty(t, u.ExternalRef())
+
+	events := u.PullEvents()
+	require.Equal(t, []shared.DomainEvent{UserAnonymized{UserID: u.ID().String(), OccurredAtTime: later}}, events)
+
+	require.ErrorIs(t, u.Rename("back", later), ErrUserAnonymized)
+	require.ErrorIs(t, u.UpdateContact(Contact{Email: "a@b.co"}, later), ErrUserAnonymized)
+	require.ErrorIs(t, u.Anonymize(later), ErrUserAnonymized)
+}
+
+func TestNew_WithIDGenerator(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := shared.NewSequentialIDGenerator(now).NewID()
+
+	u, err := New("tester", now, WithIDGenerator(shared.NewSequentialIDGenerator(now)))
+	require.NoError(t, err)
+	require.Equal(t, IDFrom(expected), u.ID())
+}
@@ -0,0 +1,94 @@
+// Package fxfile loads effective-dated FX rates from a CSV file into a
+// money.FXRateTable.
+//
+// Expected columns (header required, order free):
+//
+//	from,to,rate,effective_at[,source]
+//	USD,KRW,1350.25,2024-01-01T00:00:00Z,bok-close
+//
+// effective_at is RFC 3339. When source is absent the file name is recorded.
+package fxfile
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/shopspring/decimal"
+)
+
+var ErrMissingColumn = errors.New("fx rate file missing required column")
+
+// Load reads the file at path and builds a rate table from it.
+func Load(path string) (*money.FXRateTable, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rates, err := Parse(f, filepath.Base(path))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return money.NewFXRateTable(rates...)
+}
+
+// Parse decodes CSV rows into rates, using defaultSource when a row has none.
+func Parse(r io.Reader, defaultSource string) ([]money.FXRate, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"from", "to", "rate", "effective_at"} {
+		if _, ok := cols[name]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, name)
+		}
+	}
+	sourceCol, hasSource := cols["source"]
+
+	var rates []money.FXRate
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rates, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rate, err := decimal.NewFromString(record[cols["rate"]])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		effectiveAt, err := time.Parse(time.RFC3339, record[cols["effective_at"]])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		source := defaultSource
+		if hasSource && record[sourceCol] != "" {
+			source = record[sourceCol]
+		}
+
+		rates = append(rates, money.FXRate{
+			From:        money.Currency(strings.ToUpper(record[cols["from"]])),
+			To:          money.Currency(strings.ToUpper(record[cols["to"]])),
+			Rate:        rate,
+			EffectiveAt: effectiveAt,
+			Source:      source,
+		})
+	}
+}
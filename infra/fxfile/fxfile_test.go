@@ -0,0 +1,41 @@
+package fxfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBuildsEffectiveDatedTable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.csv")
+	require.NoError(t, os.WriteFile(path, []byte(
+		"from,to,rate,effective_at,source\n"+
+			"USD,KRW,1300,2024-01-01T00:00:00Z,\n"+
+			"USD,KRW,1350.5,2024-02-01T00:00:00Z,bok-close\n",
+	), 0o600))
+
+	table, err := Load(path)
+	require.NoError(t, err)
+
+	jan, err := table.Rate(money.CurrencyUSD, money.CurrencyKRW, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, "1300", jan.Rate.String())
+	require.Equal(t, "rates.csv", jan.Source)
+
+	feb, err := table.Rate(money.CurrencyUSD, money.CurrencyKRW, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, "1350.5", feb.Rate.String())
+	require.Equal(t, "bok-close", feb.Source)
+}
+
+func TestLoadRejectsMissingColumns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.csv")
+	require.NoError(t, os.WriteFile(path, []byte("from,to,rate\nUSD,KRW,1300\n"), 0o600))
+
+	_, err := Load(path)
+	require.ErrorIs(t, err, ErrMissingColumn)
+}
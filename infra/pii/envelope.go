@@ -0,0 +1,144 @@
+// Package pii encrypts personal data at rest with envelope encryption: every
+// value gets a fresh AES-256-GCM data key, and that data key is wrapped by a
+// master key held by a KeyProvider. Rotating the master key only requires
+// rewrapping data keys, and a leaked database dump is useless without it.
+package pii
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	envelopeVersion = 1
+	dataKeySize     = 32
+)
+
+var (
+	ErrMalformedEnvelope = errors.New("malformed pii envelope")
+	ErrUnknownKey        = errors.New("unknown master key")
+	ErrInvalidKey        = errors.New("invalid master key")
+)
+
+// KeyProvider wraps and unwraps data keys with a master key (file, KMS, HSM).
+type KeyProvider interface {
+	// WrapKey encrypts dataKey with the current master key and names that key.
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrapped []byte, err error)
+	// UnwrapKey decrypts a data key wrapped by the named master key.
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// Envelope seals and opens individual PII values.
+type Envelope struct {
+	keys KeyProvider
+}
+
+func NewEnvelope(keys KeyProvider) *Envelope {
+	return &Envelope{keys: keys}
+}
+
+// Seal encrypts plaintext bound to aad (e.g. "users.email:<id>") so a sealed
+// value copied onto another row or column fails to open. Empty plaintext seals
+// to nil so absent values stay NULL.
+//
+// Layout: version | len(keyID) | keyID | len(wrapped) uint16 | wrapped | nonce | ciphertext.
+func (e *Envelope) Seal(ctx context.Context, plaintext, aad []byte) ([]byte, error) {
+	if len(plaintext) == 0 {
+		return nil, nil
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	keyID, wrapped, err := e.keys.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(keyID) > 255 || len(wrapped) > 65535 {
+		return nil, ErrInvalidKey
+	}
+
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, 4+len(keyID)+len(wrapped)+len(nonce)+len(plaintext)+gcm.Overhead())
+	out = append(out, envelopeVersion, byte(len(keyID)))
+	out = append(out, keyID...)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(wrapped)))
+	out = append(out, wrapped...)
+	out = append(out, nonce...)
+	return gcm.Seal(out, nonce, plaintext, aad), nil
+}
+
+// Open reverses Seal; nil input opens to nil.
+func (e *Envelope) Open(ctx context.Context, sealed, aad []byte) ([]byte, error) {
+	if len(sealed) == 0 {
+		return nil, nil
+	}
+	if len(sealed) < 2 || sealed[0] != envelopeVersion {
+		return nil, ErrMalformedEnvelope
+	}
+
+	rest := sealed[1:]
+	keyLen := int(rest[0])
+	rest = rest[1:]
+	if len(rest) < keyLen+2 {
+		return nil, ErrMalformedEnvelope
+	}
+	keyID := string(rest[:keyLen])
+	rest = rest[keyLen:]
+	wrappedLen := int(binary.BigEndian.Uint16(rest))
+	rest = rest[2:]
+	if len(rest) < wrappedLen {
+		return nil, ErrMalformedEnvelope
+	}
+	wrapped := rest[:wrappedLen]
+	rest = rest[wrappedLen:]
+
+	dataKey, err := e.keys.UnwrapKey(ctx, keyID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < gcm.NonceSize() {
+		return nil, ErrMalformedEnvelope
+	}
+	nonce, ciphertext := rest[:gcm.NonceSize()], rest[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, ErrMalformedEnvelope
+	}
+	return plaintext, nil
+}
+
+// SealString and OpenString are conveniences for text columns.
+func (e *Envelope) SealString(ctx context.Context, plaintext, aad string) ([]byte, error) {
+	return e.Seal(ctx, []byte(plaintext), []byte(aad))
+}
+
+func (e *Envelope) OpenString(ctx context.Context, sealed []byte, aad string) (string, error) {
+	plaintext, err := e.Open(ctx, sealed, []byte(aad))
+	return string(plaintext), err
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	return cipher.NewGCM(block)
+}
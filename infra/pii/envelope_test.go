@@ -0,0 +1,75 @@
+package pii
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelopeRoundTripAndBinding(t *testing.T) {
+	ctx := context.Background()
+	env := NewEnvelope(mustProvider(t, "k1", "k1"))
+
+	sealed, err := env.SealString(ctx, "kim@example.com", "users.email:01H")
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(sealed, []byte("kim@example.com")))
+
+	again, err := env.SealString(ctx, "kim@example.com", "users.email:01H")
+	require.NoError(t, err)
+	require.NotEqual(t, sealed, again, "fresh data key and nonce per value")
+
+	opened, err := env.OpenString(ctx, sealed, "users.email:01H")
+	require.NoError(t, err)
+	require.Equal(t, "kim@example.com", opened)
+
+	_, err = env.OpenString(ctx, sealed, "users.phone:01H")
+	require.ErrorIs(t, err, ErrMalformedEnvelope)
+
+	empty, err := env.SealString(ctx, "", "users.email:01H")
+	require.NoError(t, err)
+	require.Nil(t, empty)
+}
+
+func TestFileKeyProviderRotation(t *testing.T) {
+	ctx := context.Background()
+	oldKey := bytes.Repeat([]byte{1}, 32)
+	newKey := bytes.Repeat([]byte{2}, 32)
+
+	before, err := NewFileKeyProvider("k1", map[string][]byte{"k1": oldKey})
+	require.NoError(t, err)
+	sealed, err := NewEnvelope(before).SealString(ctx, "+821012345678", "aad")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "keys.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"current":"k2","keys":{"k1":"`+
+		base64.StdEncoding.EncodeToString(oldKey)+`","k2":"`+
+		base64.StdEncoding.EncodeToString(newKey)+`"}}`), 0o600))
+	after, err := LoadFileKeyProvider(path)
+	require.NoError(t, err)
+
+	opened, err := NewEnvelope(after).OpenString(ctx, sealed, "aad")
+	require.NoError(t, err)
+	require.Equal(t, "+821012345678", opened)
+
+	keyID, _, err := after.WrapKey(ctx, make([]byte, 32))
+	require.NoError(t, err)
+	require.Equal(t, "k2", keyID)
+
+	_, err = NewEnvelope(mustProvider(t, "k3", "k3")).OpenString(ctx, sealed, "aad")
+	require.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func mustProvider(t *testing.T, current string, ids ...string) *FileKeyProvider {
+	keys := make(map[string][]byte, len(ids))
+	for i, id := range ids {
+		keys[id] = bytes.Repeat([]byte{byte(i + 7)}, 32)
+	}
+	p, err := NewFileKeyProvider(current, keys)
+	require.NoError(t, err)
+	return p
+}
@@ -0,0 +1,16 @@
+CREATE TABLE payment_accrual_lines (
+    id           CHAR(26)    PRIMARY KEY,
+    payment_id   CHAR(26)    NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    accrual_date DATE        NOT NULL,
+    base         NUMERIC     NOT NULL,
+    rate_bps     BIGINT      NOT NULL,
+    delta        NUMERIC     NOT NULL,
+    penalty      NUMERIC     NOT NULL,
+    currency     VARCHAR(3)  NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (payment_id, accrual_date)
+);
+
+COMMENT ON TABLE payment_accrual_lines IS 'Per-day compounding ledger explaining overdue penalties (append-only)';
+COMMENT ON COLUMN payment_accrual_lines.base IS 'Principal plus running penalty the daily rate was applied to';
+COMMENT ON COLUMN payment_accrual_lines.penalty IS 'Running penalty after applying this day''s delta';
@@ -0,0 +1,6 @@
+ALTER TABLE payments ADD COLUMN version BIGINT NOT NULL DEFAULT 0;
+
+-- rows written before this migration were saved once already
+UPDATE payments SET version = 1;
+
+COMMENT ON COLUMN payments.version IS 'Optimistic concurrency token incremented on every save';
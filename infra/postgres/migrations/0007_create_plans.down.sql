@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS plan_installments;
+DROP TABLE IF EXISTS plans;
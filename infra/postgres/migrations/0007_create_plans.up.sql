@@ -0,0 +1,29 @@
+CREATE TABLE plans (
+    id                  CHAR(26)    PRIMARY KEY,
+    user_id             CHAR(26)    NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    total               NUMERIC     NOT NULL,
+    currency            VARCHAR(3)  NOT NULL,
+    frequency           VARCHAR(20) NOT NULL,
+    remainder_placement VARCHAR(10) NOT NULL,
+    default_after_days  INTEGER     NOT NULL,
+    status              VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    version             BIGINT      NOT NULL DEFAULT 0,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE plan_installments (
+    plan_id    CHAR(26) NOT NULL REFERENCES plans(id) ON DELETE CASCADE,
+    seq        INTEGER  NOT NULL,
+    payment_id CHAR(26) NOT NULL UNIQUE REFERENCES payments(id) ON DELETE RESTRICT,
+    amount     NUMERIC  NOT NULL,
+    due_date   DATE     NOT NULL,
+    paid       BOOLEAN  NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (plan_id, seq)
+);
+
+CREATE INDEX idx_plans_user_id ON plans(user_id);
+
+COMMENT ON TABLE plans IS 'Installment plan aggregate splitting a purchase into scheduled payments';
+COMMENT ON COLUMN plans.total IS 'Purchase amount; installment amounts sum exactly to it';
+COMMENT ON TABLE plan_installments IS 'Plan slots linked to the payments that collect them';
@@ -0,0 +1,3 @@
+ALTER TABLE payments ADD COLUMN discount NUMERIC NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN payments.discount IS 'Early payment discount waived at settlement, in payment currency';
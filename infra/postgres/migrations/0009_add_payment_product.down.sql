@@ -0,0 +1,3 @@
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS rounding_mode,
+    DROP COLUMN IF EXISTS product_code;
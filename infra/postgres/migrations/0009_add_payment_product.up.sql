@@ -0,0 +1,5 @@
+ALTER TABLE payments
+    ADD COLUMN product_code  VARCHAR(50) NOT NULL DEFAULT 'DEFAULT',
+    ADD COLUMN rounding_mode VARCHAR(20) NOT NULL DEFAULT 'HALF_UP';
+
+COMMENT ON COLUMN payments.rounding_mode IS 'Rounding mode of the product at origination, applied to accrued interest';
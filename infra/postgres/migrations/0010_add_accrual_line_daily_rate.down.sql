@@ -0,0 +1,8 @@
+ALTER TABLE payment_accrual_lines
+    ADD COLUMN rate_bps BIGINT;
+
+UPDATE payment_accrual_lines SET rate_bps = ROUND(daily_rate * 10000);
+
+ALTER TABLE payment_accrual_lines
+    ALTER COLUMN rate_bps SET NOT NULL,
+    DROP COLUMN daily_rate;
@@ -0,0 +1,10 @@
+ALTER TABLE payment_accrual_lines
+    ADD COLUMN daily_rate NUMERIC;
+
+UPDATE payment_accrual_lines SET daily_rate = rate_bps / 10000.0;
+
+ALTER TABLE payment_accrual_lines
+    ALTER COLUMN daily_rate SET NOT NULL,
+    DROP COLUMN rate_bps;
+
+COMMENT ON COLUMN payment_accrual_lines.daily_rate IS 'Daily rate applied as an exact fraction (0.0005 = 5 bps)';
@@ -0,0 +1,2 @@
+ALTER TABLE payment_overdues
+    DROP COLUMN IF EXISTS carry;
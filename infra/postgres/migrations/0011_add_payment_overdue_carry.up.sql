@@ -0,0 +1,4 @@
+ALTER TABLE payment_overdues
+    ADD COLUMN carry NUMERIC NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN payment_overdues.carry IS 'Accrued interest below the minor unit not yet posted to penalty';
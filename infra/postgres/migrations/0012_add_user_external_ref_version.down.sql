@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS uq_users_external_ref;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS version,
+    DROP COLUMN IF EXISTS external_ref;
@@ -0,0 +1,11 @@
+ALTER TABLE users
+    ADD COLUMN external_ref VARCHAR(100) NULL,
+    ADD COLUMN version      BIGINT       NOT NULL DEFAULT 0;
+
+-- rows written before this migration were saved once already
+UPDATE users SET version = 1;
+
+CREATE UNIQUE INDEX uq_users_external_ref ON users(external_ref) WHERE external_ref IS NOT NULL;
+
+COMMENT ON COLUMN users.external_ref IS 'Identifier in an upstream system such as a merchant customer ID';
+COMMENT ON COLUMN users.version IS 'Optimistic concurrency token incremented on every save';
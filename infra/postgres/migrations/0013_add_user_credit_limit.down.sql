@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_payments_user_id;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS credit_currency,
+    DROP COLUMN IF EXISTS credit_limit;
@@ -0,0 +1,7 @@
+ALTER TABLE users
+    ADD COLUMN credit_limit    NUMERIC    NULL,
+    ADD COLUMN credit_currency VARCHAR(3) NULL;
+
+CREATE INDEX idx_payments_user_id ON payments(user_id);
+
+COMMENT ON COLUMN users.credit_limit IS 'Maximum outstanding BNPL exposure; NULL when no credit was granted';
@@ -0,0 +1,8 @@
+-- existing users predate KYC tracking and are treated as verified
+ALTER TABLE users
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE';
+
+ALTER TABLE users
+    ALTER COLUMN status SET DEFAULT 'PENDING_KYC';
+
+COMMENT ON COLUMN users.status IS 'Account lifecycle: PENDING_KYC, ACTIVE, SUSPENDED, CLOSED';
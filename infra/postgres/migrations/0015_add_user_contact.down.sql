@@ -0,0 +1,5 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS notification_opt_ins,
+    DROP COLUMN IF EXISTS preferred_language,
+    DROP COLUMN IF EXISTS phone_enc,
+    DROP COLUMN IF EXISTS email_enc;
@@ -0,0 +1,9 @@
+ALTER TABLE users
+    ADD COLUMN email_enc            BYTEA       NULL,
+    ADD COLUMN phone_enc            BYTEA       NULL,
+    ADD COLUMN preferred_language   VARCHAR(10) NULL,
+    ADD COLUMN notification_opt_ins TEXT[]      NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN users.email_enc IS 'Envelope-encrypted email (AES-256-GCM data key wrapped by a master key)';
+COMMENT ON COLUMN users.phone_enc IS 'Envelope-encrypted E.164 phone number';
+COMMENT ON COLUMN users.notification_opt_ins IS 'Channels the user opted into: EMAIL, SMS';
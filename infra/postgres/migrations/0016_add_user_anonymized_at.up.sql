@@ -0,0 +1,4 @@
+ALTER TABLE users
+    ADD COLUMN anonymized_at TIMESTAMPTZ NULL;
+
+COMMENT ON COLUMN users.anonymized_at IS 'Set when personal data was erased; the row is kept because payments reference it';
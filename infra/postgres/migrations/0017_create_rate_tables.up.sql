@@ -0,0 +1,21 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE rate_tables (
+    id             CHAR(26)    PRIMARY KEY,
+    product        VARCHAR(50) NOT NULL,
+    currency       VARCHAR(3)  NOT NULL,
+    effective_from TIMESTAMPTZ NOT NULL,
+    effective_to   TIMESTAMPTZ NULL,
+    daily_rate     NUMERIC     NOT NULL CHECK (daily_rate >= 0),
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (effective_to IS NULL OR effective_to > effective_from),
+    CONSTRAINT rate_tables_no_overlap EXCLUDE USING gist (
+        product WITH =,
+        currency WITH =,
+        tstzrange(effective_from, effective_to) WITH &&
+    )
+);
+
+COMMENT ON TABLE rate_tables IS 'Effective-dated daily interest rates per product and currency';
+COMMENT ON COLUMN rate_tables.effective_to IS 'Exclusive end of the range; NULL while no later change is scheduled';
+COMMENT ON COLUMN rate_tables.daily_rate IS 'Daily rate as an exact fraction (0.0005 = 5 bps)';
@@ -0,0 +1,4 @@
+ALTER TABLE payment_accrual_lines
+    ADD COLUMN rate_source VARCHAR(100) NULL;
+
+COMMENT ON COLUMN payment_accrual_lines.rate_source IS 'Pricing rule that chose the daily rate, e.g. tier:B/after:30d; NULL for a flat rate';
@@ -0,0 +1,4 @@
+ALTER TABLE payments
+    ADD COLUMN locked_daily_rate NUMERIC NULL;
+
+COMMENT ON COLUMN payments.locked_daily_rate IS 'Daily rate fixed at origination; NULL when the payment follows the rate provider';
@@ -0,0 +1,2 @@
+ALTER TABLE payment_accrual_lines
+    DROP COLUMN IF EXISTS exact_base;
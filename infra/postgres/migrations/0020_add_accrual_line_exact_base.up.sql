@@ -0,0 +1,4 @@
+ALTER TABLE payment_accrual_lines
+    ADD COLUMN exact_base NUMERIC NULL;
+
+COMMENT ON COLUMN payment_accrual_lines.exact_base IS 'Unrounded balance (principal, penalty and carry) the daily rate was applied to; NULL for lines recorded before it was kept';
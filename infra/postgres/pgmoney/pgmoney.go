@@ -0,0 +1,41 @@
+// Package pgmoney converts money.Money to and from pgx NUMERIC values so the
+// domain stays free of driver types.
+package pgmoney
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/shopspring/decimal"
+)
+
+var ErrInvalidNumeric = errors.New("invalid numeric value")
+
+// ToNumeric encodes the amount of m; the currency is stored in its own column.
+func ToNumeric(m money.Money) pgtype.Numeric {
+	return DecimalToNumeric(m.Amount())
+}
+
+// FromNumeric decodes a NUMERIC column into Money, failing when the stored
+// value has more fractional digits than the currency allows.
+func FromNumeric(n pgtype.Numeric, currency money.Currency) (money.Money, error) {
+	amount, err := NumericToDecimal(n)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.NewExact(amount, currency)
+}
+
+// DecimalToNumeric encodes a plain decimal without loss.
+func DecimalToNumeric(d decimal.Decimal) pgtype.Numeric {
+	return pgtype.Numeric{Int: d.Coefficient(), Exp: d.Exponent(), Valid: true}
+}
+
+// NumericToDecimal decodes a finite NUMERIC value.
+func NumericToDecimal(n pgtype.Numeric) (decimal.Decimal, error) {
+	if !n.Valid || n.NaN || n.InfinityModifier != pgtype.Finite || n.Int == nil {
+		return decimal.Decimal{}, ErrInvalidNumeric
+	}
+	return decimal.NewFromBigInt(n.Int, n.Exp), nil
+}
@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/pgmoney"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/sqlc/generated"
+)
+
+// AccrualLedgerRepository persists and reads the per-day accrual ledger.
+type AccrualLedgerRepository struct {
+	queries *generated.Queries
+}
+
+func NewAccrualLedgerRepository(db generated.DBTX) *AccrualLedgerRepository {
+	return &AccrualLedgerRepository{queries: generated.New(db)}
+}
+
+// Append inserts lines produced by an accrual; run it in the same transaction as the payment save.
+func (r *AccrualLedgerRepository) Append(ctx context.Context, paymentID shared.ID, lines ...payment.AccrualLine) error {
+	for _, line := range lines {
+		if err := r.queries.InsertAccrualLine(ctx, generated.InsertAccrualLineParams{
+			ID:          shared.NewID().String(),
+			PaymentID:   paymentID.String(),
+			AccrualDate: toDate(line.Date),
+			Base:        pgmoney.ToNumeric(line.Base),
+			ExactBase:   pgmoney.DecimalToNumeric(line.ExactBase),
+			DailyRate:   pgmoney.DecimalToNumeric(line.Rate.Fraction()),
+			RateSource:  toNullableText(line.RateSource),
+			Delta:       pgmoney.ToNumeric(line.Delta),
+			Penalty:     pgmoney.ToNumeric(line.Penalty),
+			Currency:    string(line.Penalty.Currency()),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *AccrualLedgerRepository) ListAccrualLines(ctx context.Context, paymentID shared.ID) ([]payment.AccrualLine, error) {
+	rows, err := r.queries.ListAccrualLinesByPayment(ctx, paymentID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]payment.AccrualLine, 0, len(rows))
+	for _, row := range rows {
+		base, err := pgmoney.FromNumeric(row.Base, money.Currency(row.Currency))
+		if err != nil {
+			return nil, err
+		}
+		delta, err := pgmoney.FromNumeric(row.Delta, money.Currency(row.Currency))
+		if err != nil {
+			return nil, err
+		}
+		penalty, err := pgmoney.FromNumeric(row.Penalty, money.Currency(row.Currency))
+		if err != nil {
+			return nil, err
+		}
+		fraction, err := pgmoney.NumericToDecimal(row.DailyRate)
+		if err != nil {
+			return nil, err
+		}
+		rate, err := money.NewRate(fraction)
+		if err != nil {
+			return nil, err
+		}
+		exactBase := base.Amount()
+		if row.ExactBase.Valid {
+			if exactBase, err = pgmoney.NumericToDecimal(row.ExactBase); err != nil {
+				return nil, err
+			}
+		}
+		lines = append(lines, payment.AccrualLine{
+			Date:       row.AccrualDate.Time,
+			Base:       base,
+			ExactBase:  exactBase,
+			Rate:       rate,
+			RateSource: fromNullableText(row.RateSource),
+			Delta:      delta,
+			Penalty:    penalty,
+		})
+	}
+	return lines, nil
+}
+
+var _ payment.AccrualLedger = (*AccrualLedgerRepository)(nil)
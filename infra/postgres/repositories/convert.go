@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func toDate(t time.Time) pgtype.Date {
+	return pgtype.Date{Time: t, Valid: true}
+}
+
+func toTimestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
+
+func toNullableTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return toTimestamptz(*t)
+}
+
+func toNullableText(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func fromNullableText(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
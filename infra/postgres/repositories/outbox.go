@@ -10,12 +10,20 @@ import (
 	"github.com/jaeyoung0509/compound-interest/usecase/event"
 )
 
+// OutboxPublisher writes events to the outbox table. Outbox IDs come from ids,
+// so a monotonic generator keeps rows in publish order for the relay.
 type OutboxPublisher struct {
 	queries *generated.Queries
+	ids     shared.IDGenerator
 }
 
-func NewOutboxPublisher(db generated.DBTX) *OutboxPublisher {
-	return &OutboxPublisher{queries: generated.New(db)}
+// NewOutboxPublisher returns a publisher writing through db. A nil ids falls
+// back to shared.DefaultIDGenerator.
+func NewOutboxPublisher(db generated.DBTX, ids shared.IDGenerator) *OutboxPublisher {
+	if ids == nil {
+		ids = shared.DefaultIDGenerator()
+	}
+	return &OutboxPublisher{queries: generated.New(db), ids: ids}
 }
 
 func (p *OutboxPublisher) Publish(ctx context.Context, events ...shared.DomainEvent) error {
@@ -30,7 +38,7 @@ func (p *OutboxPublisher) Publish(ctx context.Context, events ...shared.DomainEv
 		}
 
 		if err := p.queries.InsertOutbox(ctx, generated.InsertOutboxParams{
-			ID:            shared.NewID().String(),
+			ID:            p.ids.NewID().String(),
 			AggregateType: evt.AggregateType(),
 			AggregateID:   evt.AggregateID(),
 			EventType:     evt.EventType(),
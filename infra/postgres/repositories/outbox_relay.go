@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/sqlc/generated"
+	"github.com/jaeyoung0509/compound-interest/usecase/event"
+)
+
+const defaultRelayBatchSize = 100
+
+// OutboxRelay delivers unpublished outbox messages to a publisher, e.g. an
+// event.Dispatcher the plan service subscribes to, and marks them published.
+// Delivery is at least once, so handlers must tolerate repeats.
+type OutboxRelay struct {
+	db        TxBeginner
+	target    event.Publisher
+	decoders  map[string]decodeFunc
+	batchSize int32
+}
+
+type decodeFunc func(payload []byte) (shared.DomainEvent, error)
+
+// NewOutboxRelay relays the payment and user events written by
+// PaymentUnitOfWork and UserUnitOfWork.
+func NewOutboxRelay(db TxBeginner, target event.Publisher) *OutboxRelay {
+	return &OutboxRelay{
+		db:     db,
+		target: target,
+		decoders: map[string]decodeFunc{
+			payment.EventPaymentPaid:           decodeEvent[payment.PaymentPaid],
+			payment.EventPaymentOverdueAccrued: decodeEvent[payment.OverdueAccrued],
+			user.EventUserStatusChanged:        decodeEvent[user.StatusChanged],
+			user.EventUserAnonymized:           decodeEvent[user.UserAnonymized],
+		},
+		batchSize: defaultRelayBatchSize,
+	}
+}
+
+// RelayBatch claims up to one batch of messages in a transaction and delivers
+// them in publish order. A message that cannot be delivered has its attempts
+// counted and stays unpublished for the next batch; those failures are
+// returned joined alongside the number of messages published.
+func (r *OutboxRelay) RelayBatch(ctx context.Context) (int, error) {
+	published := 0
+	var failures []error
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		queries := generated.New(tx)
+		messages, err := queries.ClaimOutboxMessages(ctx, generated.ClaimOutboxMessagesParams{
+			EventTypes: slices.Sorted(maps.Keys(r.decoders)),
+			BatchSize:  r.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range messages {
+			evt, err := r.decoders[msg.EventType](msg.Payload)
+			if err == nil {
+				err = r.target.Publish(ctx, evt)
+			}
+			if err != nil {
+				failures = append(failures, fmt.Errorf("outbox message %s: %w", msg.ID, err))
+				if err := queries.RecordOutboxFailure(ctx, msg.ID); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := queries.MarkOutboxPublished(ctx, msg.ID); err != nil {
+				return err
+			}
+			published++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return published, errors.Join(failures...)
+}
+
+func decodeEvent[E shared.DomainEvent](payload []byte) (shared.DomainEvent, error) {
+	var evt E
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
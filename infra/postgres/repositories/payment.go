@@ -0,0 +1,220 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/pgmoney"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/sqlc/generated"
+)
+
+// PaymentRepository persists the Payment aggregate with its overdue snapshots
+// and accrual ledger. Pass a pgx.Tx as db to make Save atomic.
+type PaymentRepository struct {
+	queries *generated.Queries
+	ledger  *AccrualLedgerRepository
+}
+
+func NewPaymentRepository(db generated.DBTX) *PaymentRepository {
+	return &PaymentRepository{
+		queries: generated.New(db),
+		ledger:  NewAccrualLedgerRepository(db),
+	}
+}
+
+func (r *PaymentRepository) Get(ctx context.Context, id shared.ID) (*payment.Payment, error) {
+	row, err := r.queries.GetPayment(ctx, id.String())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, payment.ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toPayment(ctx, row)
+}
+
+// ListByUser loads every payment of the user ordered by due date.
+func (r *PaymentRepository) ListByUser(ctx context.Context, userID user.ID) ([]*payment.Payment, error) {
+	rows, err := r.queries.ListPaymentsByUser(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	payments := make([]*payment.Payment, 0, len(rows))
+	for _, row := range rows {
+		p, err := r.toPayment(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
+	return payments, nil
+}
+
+func (r *PaymentRepository) toPayment(ctx context.Context, row generated.Payment) (*payment.Payment, error) {
+	id, err := shared.ParseID(row.ID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := shared.ParseID(row.UserID)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := pgmoney.FromNumeric(row.Amount, money.Currency(row.Currency))
+	if err != nil {
+		return nil, err
+	}
+	discount, err := pgmoney.FromNumeric(row.Discount, money.Currency(row.Currency))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := payment.Snapshot{
+		ID:     id,
+		UserID: user.IDFrom(userID),
+		Amount: amount,
+		Product: payment.Product{
+			Code:     row.ProductCode,
+			Rounding: money.RoundingMode(row.RoundingMode),
+		},
+		DueDate:   row.DueDate.Time,
+		Discount:  discount,
+		Status:    payment.Status(row.Status),
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+		Version:   row.Version,
+	}
+	if row.PaidAt.Valid {
+		paidAt := row.PaidAt.Time
+		snapshot.PaidAt = &paidAt
+	}
+	if row.LockedDailyRate.Valid {
+		locked, err := pgmoney.NumericToDecimal(row.LockedDailyRate)
+		if err != nil {
+			return nil, err
+		}
+		rate, err := money.NewRate(locked)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.LockedRate = &rate
+	}
+
+	overdue, err := r.latestOverdue(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Overdue = overdue
+
+	return payment.Reconstitute(snapshot)
+}
+
+func (r *PaymentRepository) latestOverdue(ctx context.Context, id shared.ID) (*payment.OverdueInfo, error) {
+	row, err := r.queries.GetLatestPaymentOverdue(ctx, id.String())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	overdueID, err := shared.ParseID(row.ID)
+	if err != nil {
+		return nil, err
+	}
+	penalty, err := pgmoney.FromNumeric(row.Penalty, money.Currency(row.PenaltyCurrency))
+	if err != nil {
+		return nil, err
+	}
+	carry, err := pgmoney.NumericToDecimal(row.Carry)
+	if err != nil {
+		return nil, err
+	}
+	return &payment.OverdueInfo{
+		ID:           overdueID,
+		IsOverdue:    row.IsOverdue,
+		DaysOverdue:  int(row.DaysOverdue),
+		Penalty:      penalty,
+		Carry:        carry,
+		CalculatedAt: row.CalculatedAt.Time,
+	}, nil
+}
+
+func (r *PaymentRepository) Save(ctx context.Context, p *payment.Payment) error {
+	rows, err := r.upsert(ctx, p)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return payment.ErrConcurrentModification
+	}
+
+	if info := p.OverdueInfo(); info != nil {
+		if err := r.queries.InsertPaymentOverdue(ctx, generated.InsertPaymentOverdueParams{
+			ID:              info.ID.String(),
+			PaymentID:       p.ID().String(),
+			IsOverdue:       info.IsOverdue,
+			DaysOverdue:     int32(info.DaysOverdue),
+			Penalty:         pgmoney.ToNumeric(info.Penalty),
+			PenaltyCurrency: string(info.Penalty.Currency()),
+			CalculatedAt:    toDate(info.CalculatedAt),
+			Carry:           pgmoney.DecimalToNumeric(info.Carry),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := r.ledger.Append(ctx, p.ID(), p.PullAccrualLines()...); err != nil {
+		return err
+	}
+
+	p.IncrementVersion()
+	return nil
+}
+
+func (r *PaymentRepository) upsert(ctx context.Context, p *payment.Payment) (int64, error) {
+	if p.Version() == 0 {
+		var lockedRate pgtype.Numeric
+		if rate, ok := p.LockedRate(); ok {
+			lockedRate = pgmoney.DecimalToNumeric(rate.Fraction())
+		}
+		return r.queries.InsertPayment(ctx, generated.InsertPaymentParams{
+			ID:              p.ID().String(),
+			UserID:          p.UserID().String(),
+			Amount:          pgmoney.ToNumeric(p.Amount()),
+			Currency:        string(p.Amount().Currency()),
+			ProductCode:     p.Product().Code,
+			RoundingMode:    string(p.Product().Rounding),
+			DueDate:         toDate(p.DueDate()),
+			PaidAt:          toNullableTimestamptz(p.PaidAt()),
+			Discount:        pgmoney.ToNumeric(p.Discount()),
+			Status:          string(p.Status()),
+			CreatedAt:       toTimestamptz(p.CreatedAt()),
+			UpdatedAt:       toTimestamptz(p.UpdatedAt()),
+			LockedDailyRate: lockedRate,
+		})
+	}
+	return r.queries.UpdatePayment(ctx, generated.UpdatePaymentParams{
+		ID:        p.ID().String(),
+		PaidAt:    toNullableTimestamptz(p.PaidAt()),
+		Discount:  pgmoney.ToNumeric(p.Discount()),
+		Status:    string(p.Status()),
+		UpdatedAt: toTimestamptz(p.UpdatedAt()),
+		Version:   p.Version(),
+	})
+}
+
+func (r *PaymentRepository) ListAccrualLines(ctx context.Context, paymentID shared.ID) ([]payment.AccrualLine, error) {
+	return r.ledger.ListAccrualLines(ctx, paymentID)
+}
+
+var (
+	_ payment.Repository    = (*PaymentRepository)(nil)
+	_ payment.AccrualLedger = (*PaymentRepository)(nil)
+	_ payment.UserPayments  = (*PaymentRepository)(nil)
+)
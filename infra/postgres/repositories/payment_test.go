@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentRepository_SavesPaymentsWrittenBeforeVersioning(t *testing.T) {
+	ctx := context.Background()
+	conn := openTestDB(t)
+	migrate(t, conn, "", "0005")
+
+	userID, paymentID := shared.NewID(), shared.NewID()
+	_, err := conn.Exec(ctx, `INSERT INTO users (id, name) VALUES ($1, 'legacy')`, userID.String())
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, `INSERT INTO payments (id, user_id, amount, currency, due_date)
+		VALUES ($1, $2, 10000, 'KRW', '2024-01-31')`, paymentID.String(), userID.String())
+	require.NoError(t, err)
+
+	migrate(t, conn, "0005", "")
+
+	repo := NewPaymentRepository(conn)
+	p, err := repo.Get(ctx, paymentID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), p.Version())
+
+	require.NoError(t, p.Pay(time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)))
+	require.NoError(t, repo.Save(ctx, p))
+
+	reloaded, err := repo.Get(ctx, paymentID)
+	require.NoError(t, err)
+	require.Equal(t, payment.StatusPaid, reloaded.Status())
+	require.Equal(t, int64(2), reloaded.Version())
+}
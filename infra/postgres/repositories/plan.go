@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/plan"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/pgmoney"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/sqlc/generated"
+)
+
+// PlanRepository persists the Plan aggregate and its installments. Pass a
+// pgx.Tx as db to make Save atomic.
+type PlanRepository struct {
+	queries *generated.Queries
+}
+
+func NewPlanRepository(db generated.DBTX) *PlanRepository {
+	return &PlanRepository{queries: generated.New(db)}
+}
+
+func (r *PlanRepository) Get(ctx context.Context, id shared.ID) (*plan.Plan, error) {
+	row, err := r.queries.GetPlan(ctx, id.String())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, plan.ErrPlanNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := shared.ParseID(row.UserID)
+	if err != nil {
+		return nil, err
+	}
+	total, err := pgmoney.FromNumeric(row.Total, money.Currency(row.Currency))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.ListPlanInstallments(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	installments := make([]plan.Installment, 0, len(rows))
+	for _, inst := range rows {
+		paymentID, err := shared.ParseID(inst.PaymentID)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := pgmoney.FromNumeric(inst.Amount, money.Currency(row.Currency))
+		if err != nil {
+			return nil, err
+		}
+		installments = append(installments, plan.Installment{
+			Seq:       int(inst.Seq),
+			PaymentID: paymentID,
+			Amount:    amount,
+			DueDate:   inst.DueDate.Time,
+			Paid:      inst.Paid,
+		})
+	}
+
+	return plan.Reconstitute(plan.Snapshot{
+		ID:               id,
+		UserID:           user.IDFrom(userID),
+		Total:            total,
+		Frequency:        plan.Frequency(row.Frequency),
+		Remainder:        plan.RemainderPlacement(row.RemainderPlacement),
+		DefaultAfterDays: int(row.DefaultAfterDays),
+		Installments:     installments,
+		Status:           plan.Status(row.Status),
+		CreatedAt:        row.CreatedAt.Time,
+		UpdatedAt:        row.UpdatedAt.Time,
+		Version:          row.Version,
+	})
+}
+
+func (r *PlanRepository) FindByPaymentID(ctx context.Context, paymentID shared.ID) (*plan.Plan, error) {
+	planID, err := r.queries.GetPlanIDByPaymentID(ctx, paymentID.String())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, plan.ErrPlanNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	id, err := shared.ParseID(planID)
+	if err != nil {
+		return nil, err
+	}
+	return r.Get(ctx, id)
+}
+
+// Save writes the plan row and its installments. Child payments are saved
+// through the payment repository beforehand so the foreign keys resolve.
+func (r *PlanRepository) Save(ctx context.Context, p *plan.Plan) error {
+	rows, err := r.upsert(ctx, p)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return plan.ErrConcurrentModification
+	}
+
+	for _, inst := range p.Installments() {
+		if err := r.queries.UpsertPlanInstallment(ctx, generated.UpsertPlanInstallmentParams{
+			PlanID:    p.ID().String(),
+			Seq:       int32(inst.Seq),
+			PaymentID: inst.PaymentID.String(),
+			Amount:    pgmoney.ToNumeric(inst.Amount),
+			DueDate:   toDate(inst.DueDate),
+			Paid:      inst.Paid,
+		}); err != nil {
+			return err
+		}
+	}
+
+	p.IncrementVersion()
+	return nil
+}
+
+func (r *PlanRepository) upsert(ctx context.Context, p *plan.Plan) (int64, error) {
+	if p.Version() == 0 {
+		return r.queries.InsertPlan(ctx, generated.InsertPlanParams{
+			ID:                 p.ID().String(),
+			UserID:             p.UserID().String(),
+			Total:              pgmoney.ToNumeric(p.Total()),
+			Currency:           string(p.Total().Currency()),
+			Frequency:          string(p.Frequency()),
+			RemainderPlacement: string(p.Remainder()),
+			DefaultAfterDays:   int32(p.DefaultAfterDays()),
+			Status:             string(p.Status()),
+			CreatedAt:          toTimestamptz(p.CreatedAt()),
+			UpdatedAt:          toTimestamptz(p.UpdatedAt()),
+		})
+	}
+	return r.queries.UpdatePlan(ctx, generated.UpdatePlanParams{
+		ID:        p.ID().String(),
+		Status:    string(p.Status()),
+		UpdatedAt: toTimestamptz(p.UpdatedAt()),
+		Version:   p.Version(),
+	})
+}
+
+var _ plan.Repository = (*PlanRepository)(nil)
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// openTestDB connects to TEST_DATABASE_URL inside a throwaway schema that is
+// dropped when the test ends. Tests skip when the variable is unset.
+func openTestDB(t *testing.T) *pgx.Conn {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, url)
+	require.NoError(t, err)
+
+	schema := fmt.Sprintf("test_%d", time.Now().UnixNano())
+	_, err = conn.Exec(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "SET search_path TO "+schema+", public")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = conn.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+		_ = conn.Close(context.Background())
+	})
+	return conn
+}
+
+// migrate applies the up migrations numbered after `after` up to and
+// including `through`; an empty bound is open.
+func migrate(t *testing.T, conn *pgx.Conn, after, through string) {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join("..", "migrations", "*.up.sql"))
+	require.NoError(t, err)
+	sort.Strings(files)
+	for _, file := range files {
+		version, _, _ := strings.Cut(filepath.Base(file), "_")
+		if after != "" && version <= after || through != "" && version > through {
+			continue
+		}
+		sql, err := os.ReadFile(file)
+		require.NoError(t, err)
+		_, err = conn.Exec(context.Background(), string(sql))
+		require.NoError(t, err, file)
+	}
+}
@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/rate"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/pgmoney"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/sqlc/generated"
+)
+
+// exclusionViolation is raised by the rate_tables_no_overlap constraint.
+const exclusionViolation = "23P01"
+
+// TxDB runs queries and opens transactions; *pgxpool.Pool, *pgx.Conn and
+// pgx.Tx all qualify.
+type TxDB interface {
+	generated.DBTX
+	TxBeginner
+}
+
+// RateTableRepository persists effective-dated rates.
+type RateTableRepository struct {
+	db      TxDB
+	queries *generated.Queries
+}
+
+func NewRateTableRepository(db TxDB) *RateTableRepository {
+	return &RateTableRepository{db: db, queries: generated.New(db)}
+}
+
+func (r *RateTableRepository) Table(ctx context.Context, key rate.Key) (rate.Table, error) {
+	rows, err := r.queries.ListRateTableEntries(ctx, generated.ListRateTableEntriesParams{
+		Product:  key.Product,
+		Currency: string(key.Currency),
+	})
+	if err != nil {
+		return rate.Table{}, err
+	}
+
+	entries := make([]rate.Entry, 0, len(rows))
+	for _, row := range rows {
+		id, err := shared.ParseID(row.ID)
+		if err != nil {
+			return rate.Table{}, err
+		}
+		fraction, err := pgmoney.NumericToDecimal(row.DailyRate)
+		if err != nil {
+			return rate.Table{}, err
+		}
+		dailyRate, err := money.NewRate(fraction)
+		if err != nil {
+			return rate.Table{}, err
+		}
+		entry := rate.Entry{
+			ID:            id,
+			Key:           rate.Key{Product: row.Product, Currency: money.Currency(row.Currency)},
+			EffectiveFrom: row.EffectiveFrom.Time,
+			DailyRate:     dailyRate,
+			CreatedAt:     row.CreatedAt.Time,
+		}
+		if row.EffectiveTo.Valid {
+			end := row.EffectiveTo.Time
+			entry.EffectiveTo = &end
+		}
+		entries = append(entries, entry)
+	}
+	return rate.NewTable(key, entries...)
+}
+
+// Apply closes the superseded entry and inserts the new one in a single
+// transaction (a savepoint when db is already a pgx.Tx). Closing first keeps
+// the exclusion constraint from seeing a transient overlap.
+func (r *RateTableRepository) Apply(ctx context.Context, change rate.Change) error {
+	return pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		return applyRateChange(ctx, r.queries.WithTx(tx), change)
+	})
+}
+
+func applyRateChange(ctx context.Context, queries *generated.Queries, change rate.Change) error {
+	if closed := change.Closed; closed != nil {
+		rows, err := queries.CloseRateTableEntry(ctx, generated.CloseRateTableEntryParams{
+			ID:          closed.ID.String(),
+			EffectiveTo: toNullableTimestamptz(closed.EffectiveTo),
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			// Another change already ended the entry at or before this point.
+			return rate.ErrOverlappingRange
+		}
+	}
+
+	added := change.Added
+	err := queries.InsertRateTableEntry(ctx, generated.InsertRateTableEntryParams{
+		ID:            added.ID.String(),
+		Product:       added.Key.Product,
+		Currency:      string(added.Key.Currency),
+		EffectiveFrom: toTimestamptz(added.EffectiveFrom),
+		EffectiveTo:   toNullableTimestamptz(added.EffectiveTo),
+		DailyRate:     pgmoney.DecimalToNumeric(added.DailyRate.Fraction()),
+		CreatedAt:     toTimestamptz(added.CreatedAt),
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == exclusionViolation {
+		return rate.ErrOverlappingRange
+	}
+	return err
+}
+
+var _ rate.Repository = (*RateTableRepository)(nil)
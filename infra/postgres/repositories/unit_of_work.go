@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/plan"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/infra/pii"
+	"github.com/jaeyoung0509/compound-interest/usecase/event"
+	upayment "github.com/jaeyoung0509/compound-interest/usecase/payment"
+	uplan "github.com/jaeyoung0509/compound-interest/usecase/plan"
+	uuser "github.com/jaeyoung0509/compound-interest/usecase/user"
+)
+
+// TxBeginner starts transactions; *pgxpool.Pool, *pgx.Conn and pgx.Tx (as a
+// savepoint) all qualify.
+type TxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// PaymentUnitOfWork saves payments and writes their events to the outbox in
+// one transaction.
+type PaymentUnitOfWork struct {
+	db  TxBeginner
+	ids shared.IDGenerator
+}
+
+// NewPaymentUnitOfWork issues outbox IDs from ids; nil uses the default generator.
+func NewPaymentUnitOfWork(db TxBeginner, ids shared.IDGenerator) *PaymentUnitOfWork {
+	return &PaymentUnitOfWork{db: db, ids: ids}
+}
+
+func (u *PaymentUnitOfWork) Do(ctx context.Context, fn func(payments payment.Repository, events event.Publisher) error) error {
+	return pgx.BeginFunc(ctx, u.db, func(tx pgx.Tx) error {
+		return fn(NewPaymentRepository(tx), NewOutboxPublisher(tx, u.ids))
+	})
+}
+
+var _ upayment.UnitOfWork = (*PaymentUnitOfWork)(nil)
+
+// PlanUnitOfWork stores a plan and its installment payments in one transaction.
+type PlanUnitOfWork struct {
+	db TxBeginner
+}
+
+func NewPlanUnitOfWork(db TxBeginner) *PlanUnitOfWork {
+	return &PlanUnitOfWork{db: db}
+}
+
+func (u *PlanUnitOfWork) Do(ctx context.Context, fn func(plans plan.Repository, payments payment.Repository) error) error {
+	return pgx.BeginFunc(ctx, u.db, func(tx pgx.Tx) error {
+		return fn(NewPlanRepository(tx), NewPaymentRepository(tx))
+	})
+}
+
+var _ uplan.UnitOfWork = (*PlanUnitOfWork)(nil)
+
+// UserUnitOfWork saves users, reads their payments and writes their events to
+// the outbox in one transaction. Users loaded in it stay locked (FOR UPDATE)
+// until it ends, which also holds off payment inserts referencing them, so
+// checks on a user's payments still hold when the user is saved.
+type UserUnitOfWork struct {
+	db  TxBeginner
+	pii *pii.Envelope
+	ids shared.IDGenerator
+}
+
+// NewUserUnitOfWork seals contact details with envelope and issues outbox IDs
+// from ids; nil uses the default generator.
+func NewUserUnitOfWork(db TxBeginner, envelope *pii.Envelope, ids shared.IDGenerator) *UserUnitOfWork {
+	return &UserUnitOfWork{db: db, pii: envelope, ids: ids}
+}
+
+func (u *UserUnitOfWork) Do(ctx context.Context, fn func(users user.Repository, payments payment.UserPayments, events event.Publisher) error) error {
+	return pgx.BeginFunc(ctx, u.db, func(tx pgx.Tx) error {
+		users := NewUserRepository(tx, u.pii)
+		users.forUpdate = true
+		return fn(users, NewPaymentRepository(tx), NewOutboxPublisher(tx, u.ids))
+	})
+}
+
+var _ uuser.UnitOfWork = (*UserUnitOfWork)(nil)
@@ -0,0 +1,194 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/infra/pii"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/pgmoney"
+	"github.com/jaeyoung0509/compound-interest/infra/postgres/sqlc/generated"
+)
+
+const uniqueViolation = "23505"
+
+// UserRepository persists the User aggregate. Email and phone are sealed with
+// the envelope before they reach the database.
+type UserRepository struct {
+	queries *generated.Queries
+	pii     *pii.Envelope
+	// forUpdate makes Get lock the row until the transaction ends.
+	forUpdate bool
+}
+
+func NewUserRepository(db generated.DBTX, envelope *pii.Envelope) *UserRepository {
+	return &UserRepository{queries: generated.New(db), pii: envelope}
+}
+
+func (r *UserRepository) Get(ctx context.Context, id user.ID) (*user.User, error) {
+	get := r.queries.GetUser
+	if r.forUpdate {
+		get = r.queries.GetUserForUpdate
+	}
+	row, err := get(ctx, id.String())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, user.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toUser(ctx, row)
+}
+
+func (r *UserRepository) FindByExternalRef(ctx context.Context, ref string) (*user.User, error) {
+	if ref == "" {
+		return nil, user.ErrUserNotFound
+	}
+	row, err := r.queries.GetUserByExternalRef(ctx, &ref)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, user.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.toUser(ctx, row)
+}
+
+func (r *UserRepository) Save(ctx context.Context, u *user.User) error {
+	rows, err := r.upsert(ctx, u)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return user.ErrExternalRefTaken
+	}
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return user.ErrConcurrentModification
+	}
+	u.IncrementVersion()
+	return nil
+}
+
+func (r *UserRepository) upsert(ctx context.Context, u *user.User) (int64, error) {
+	var (
+		creditLimit    pgtype.Numeric
+		creditCurrency *string
+	)
+	if limit := u.CreditLimit(); limit.Currency() != "" {
+		creditLimit = pgmoney.ToNumeric(limit)
+		creditCurrency = toNullableText(string(limit.Currency()))
+	}
+
+	id := u.ID().String()
+	contact := u.Contact()
+	emailEnc, err := r.pii.SealString(ctx, contact.Email, emailAAD(id))
+	if err != nil {
+		return 0, err
+	}
+	phoneEnc, err := r.pii.SealString(ctx, contact.Phone, phoneAAD(id))
+	if err != nil {
+		return 0, err
+	}
+	prefs := u.Preferences()
+	optIns := make([]string, len(prefs.OptIns))
+	for i, ch := range prefs.OptIns {
+		optIns[i] = string(ch)
+	}
+
+	if u.Version() == 0 {
+		return r.queries.InsertUser(ctx, generated.InsertUserParams{
+			ID:                 id,
+			Name:               u.Name(),
+			ExternalRef:        toNullableText(u.ExternalRef()),
+			CreditLimit:        creditLimit,
+			CreditCurrency:     creditCurrency,
+			Status:             string(u.Status()),
+			EmailEnc:           emailEnc,
+			PhoneEnc:           phoneEnc,
+			PreferredLanguage:  toNullableText(prefs.Language),
+			NotificationOptIns: optIns,
+			CreatedAt:          toTimestamptz(u.CreatedAt()),
+			UpdatedAt:          toTimestamptz(u.UpdatedAt()),
+		})
+	}
+	return r.queries.UpdateUser(ctx, generated.UpdateUserParams{
+		ID:                 id,
+		Name:               u.Name(),
+		ExternalRef:        toNullableText(u.ExternalRef()),
+		CreditLimit:        creditLimit,
+		CreditCurrency:     creditCurrency,
+		Status:             string(u.Status()),
+		EmailEnc:           emailEnc,
+		PhoneEnc:           phoneEnc,
+		PreferredLanguage:  toNullableText(prefs.Language),
+		NotificationOptIns: optIns,
+		AnonymizedAt:       toNullableTimestamptz(u.AnonymizedAt()),
+		UpdatedAt:          toTimestamptz(u.UpdatedAt()),
+		Version:            u.Version(),
+	})
+}
+
+func (r *UserRepository) toUser(ctx context.Context, row generated.User) (*user.User, error) {
+	id, err := shared.ParseID(row.ID)
+	if err != nil {
+		return nil, err
+	}
+	var creditLimit money.Money
+	if row.CreditLimit.Valid && row.CreditCurrency != nil {
+		if creditLimit, err = pgmoney.FromNumeric(row.CreditLimit, money.Currency(*row.CreditCurrency)); err != nil {
+			return nil, err
+		}
+	}
+	email, err := r.pii.OpenString(ctx, row.EmailEnc, emailAAD(row.ID))
+	if err != nil {
+		return nil, err
+	}
+	phone, err := r.pii.OpenString(ctx, row.PhoneEnc, phoneAAD(row.ID))
+	if err != nil {
+		return nil, err
+	}
+	optIns := make([]user.Channel, len(row.NotificationOptIns))
+	for i, ch := range row.NotificationOptIns {
+		optIns[i] = user.Channel(ch)
+	}
+	var anonymizedAt *time.Time
+	if row.AnonymizedAt.Valid {
+		t := row.AnonymizedAt.Time
+		anonymizedAt = &t
+	}
+
+	return user.Reconstitute(user.Snapshot{
+		ID:          user.IDFrom(id),
+		Name:        row.Name,
+		ExternalRef: fromNullableText(row.ExternalRef),
+		CreditLimit: creditLimit,
+		Status:      user.Status(row.Status),
+		Contact:     user.Contact{Email: email, Phone: phone},
+		Preferences: user.Preferences{
+			Language: fromNullableText(row.PreferredLanguage),
+			OptIns:   optIns,
+		},
+		AnonymizedAt: anonymizedAt,
+		CreatedAt:    row.CreatedAt.Time,
+		UpdatedAt:    row.UpdatedAt.Time,
+		Version:      row.Version,
+	})
+}
+
+// The AADs bind each sealed value to its row and column.
+func emailAAD(id string) string {
+	return "users.email_enc:" + id
+}
+
+func phoneAAD(id string) string {
+	return "users.phone_enc:" + id
+}
+
+var _ user.Repository = (*UserRepository)(nil)
@@ -33,6 +33,35 @@ type Payment struct {
 	Status    string             `json:"status"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	// Optimistic concurrency token incremented on every save
+	Version int64 `json:"version"`
+	// Early payment discount waived at settlement, in payment currency
+	Discount    pgtype.Numeric `json:"discount"`
+	ProductCode string         `json:"product_code"`
+	// Rounding mode of the product at origination, applied to accrued interest
+	RoundingMode string `json:"rounding_mode"`
+	// Daily rate fixed at origination; NULL when the payment follows the rate provider
+	LockedDailyRate pgtype.Numeric `json:"locked_daily_rate"`
+}
+
+// Per-day compounding ledger explaining overdue penalties (append-only)
+type PaymentAccrualLine struct {
+	ID          string      `json:"id"`
+	PaymentID   string      `json:"payment_id"`
+	AccrualDate pgtype.Date `json:"accrual_date"`
+	// Principal plus running penalty the daily rate was applied to
+	Base  pgtype.Numeric `json:"base"`
+	Delta pgtype.Numeric `json:"delta"`
+	// Running penalty after applying this day's delta
+	Penalty   pgtype.Numeric     `json:"penalty"`
+	Currency  string             `json:"currency"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	// Daily rate applied as an exact fraction (0.0005 = 5 bps)
+	DailyRate pgtype.Numeric `json:"daily_rate"`
+	// Pricing rule that chose the daily rate, e.g. tier:B/after:30d; NULL for a flat rate
+	RateSource *string `json:"rate_source"`
+	// Unrounded balance (principal, penalty and carry) the daily rate was applied to; NULL for lines recorded before it was kept
+	ExactBase pgtype.Numeric `json:"exact_base"`
 }
 
 // Immutable snapshots of overdue calculations (append-only history)
@@ -45,6 +74,47 @@ type PaymentOverdue struct {
 	PenaltyCurrency string             `json:"penalty_currency"`
 	CalculatedAt    pgtype.Date        `json:"calculated_at"`
 	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	// Accrued interest below the minor unit not yet posted to penalty
+	Carry pgtype.Numeric `json:"carry"`
+}
+
+// Installment plan aggregate splitting a purchase into scheduled payments
+type Plan struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	// Purchase amount; installment amounts sum exactly to it
+	Total              pgtype.Numeric     `json:"total"`
+	Currency           string             `json:"currency"`
+	Frequency          string             `json:"frequency"`
+	RemainderPlacement string             `json:"remainder_placement"`
+	DefaultAfterDays   int32              `json:"default_after_days"`
+	Status             string             `json:"status"`
+	Version            int64              `json:"version"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+}
+
+// Plan slots linked to the payments that collect them
+type PlanInstallment struct {
+	PlanID    string         `json:"plan_id"`
+	Seq       int32          `json:"seq"`
+	PaymentID string         `json:"payment_id"`
+	Amount    pgtype.Numeric `json:"amount"`
+	DueDate   pgtype.Date    `json:"due_date"`
+	Paid      bool           `json:"paid"`
+}
+
+// Effective-dated daily interest rates per product and currency
+type RateTable struct {
+	ID            string             `json:"id"`
+	Product       string             `json:"product"`
+	Currency      string             `json:"currency"`
+	EffectiveFrom pgtype.Timestamptz `json:"effective_from"`
+	// Exclusive end of the range; NULL while no later change is scheduled
+	EffectiveTo pgtype.Timestamptz `json:"effective_to"`
+	// Daily rate as an exact fraction (0.0005 = 5 bps)
+	DailyRate pgtype.Numeric     `json:"daily_rate"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
 // User aggregate storing identity info
@@ -54,4 +124,22 @@ type User struct {
 	Name      string             `json:"name"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	// Identifier in an upstream system such as a merchant customer ID
+	ExternalRef *string `json:"external_ref"`
+	// Optimistic concurrency token incremented on every save
+	Version int64 `json:"version"`
+	// Maximum outstanding BNPL exposure; NULL when no credit was granted
+	CreditLimit    pgtype.Numeric `json:"credit_limit"`
+	CreditCurrency *string        `json:"credit_currency"`
+	// Account lifecycle: PENDING_KYC, ACTIVE, SUSPENDED, CLOSED
+	Status string `json:"status"`
+	// Envelope-encrypted email (AES-256-GCM data key wrapped by a master key)
+	EmailEnc []byte `json:"email_enc"`
+	// Envelope-encrypted E.164 phone number
+	PhoneEnc          []byte  `json:"phone_enc"`
+	PreferredLanguage *string `json:"preferred_language"`
+	// Channels the user opted into: EMAIL, SMS
+	NotificationOptIns []string `json:"notification_opt_ins"`
+	// Set when personal data was erased; the row is kept because payments reference it
+	AnonymizedAt pgtype.Timestamptz `json:"anonymized_at"`
 }
@@ -11,6 +11,49 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const claimOutboxMessages = `-- name: ClaimOutboxMessages :many
+SELECT id, aggregate_type, aggregate_id, event_type, payload, occurred_at, created_at, published_at, attempts FROM outbox_messages
+WHERE published_at IS NULL AND event_type = ANY($1::text[])
+ORDER BY created_at, id
+LIMIT $2
+FOR UPDATE SKIP LOCKED
+`
+
+type ClaimOutboxMessagesParams struct {
+	EventTypes []string `json:"event_types"`
+	BatchSize  int32    `json:"batch_size"`
+}
+
+func (q *Queries) ClaimOutboxMessages(ctx context.Context, arg ClaimOutboxMessagesParams) ([]OutboxMessage, error) {
+	rows, err := q.db.Query(ctx, claimOutboxMessages, arg.EventTypes, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OutboxMessage
+	for rows.Next() {
+		var i OutboxMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.EventType,
+			&i.Payload,
+			&i.OccurredAt,
+			&i.CreatedAt,
+			&i.PublishedAt,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertOutbox = `-- name: InsertOutbox :exec
 INSERT INTO outbox_messages (
     id, aggregate_type, aggregate_id, event_type, payload, occurred_at
@@ -38,3 +81,21 @@ func (q *Queries) InsertOutbox(ctx context.Context, arg InsertOutboxParams) erro
 	)
 	return err
 }
+
+const markOutboxPublished = `-- name: MarkOutboxPublished :exec
+UPDATE outbox_messages SET published_at = NOW() WHERE id = $1
+`
+
+func (q *Queries) MarkOutboxPublished(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, markOutboxPublished, id)
+	return err
+}
+
+const recordOutboxFailure = `-- name: RecordOutboxFailure :exec
+UPDATE outbox_messages SET attempts = attempts + 1 WHERE id = $1
+`
+
+func (q *Queries) RecordOutboxFailure(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, recordOutboxFailure, id)
+	return err
+}
@@ -0,0 +1,10 @@
+-- name: InsertAccrualLine :exec
+INSERT INTO payment_accrual_lines (
+    id, payment_id, accrual_date, base, exact_base, daily_rate, rate_source, delta, penalty, currency
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: ListAccrualLinesByPayment :many
+SELECT * FROM payment_accrual_lines
+WHERE payment_id = $1
+ORDER BY accrual_date;
@@ -3,3 +3,16 @@ INSERT INTO outbox_messages (
     id, aggregate_type, aggregate_id, event_type, payload, occurred_at
 )
 VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ClaimOutboxMessages :many
+SELECT * FROM outbox_messages
+WHERE published_at IS NULL AND event_type = ANY(sqlc.arg(event_types)::text[])
+ORDER BY created_at, id
+LIMIT sqlc.arg(batch_size)
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxPublished :exec
+UPDATE outbox_messages SET published_at = NOW() WHERE id = $1;
+
+-- name: RecordOutboxFailure :exec
+UPDATE outbox_messages SET attempts = attempts + 1 WHERE id = $1;
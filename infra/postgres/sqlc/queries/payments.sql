@@ -0,0 +1,37 @@
+-- name: GetPayment :one
+SELECT * FROM payments
+WHERE id = $1;
+
+-- name: ListPaymentsByUser :many
+SELECT * FROM payments
+WHERE user_id = $1
+ORDER BY due_date, id;
+
+-- name: InsertPayment :execrows
+INSERT INTO payments (
+    id, user_id, amount, currency, product_code, rounding_mode, due_date, paid_at, discount, status, created_at, updated_at, locked_daily_rate, version
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, 1)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: UpdatePayment :execrows
+UPDATE payments
+SET paid_at = $2,
+    discount = $3,
+    status = $4,
+    updated_at = $5,
+    version = version + 1
+WHERE id = $1 AND version = $6;
+
+-- name: GetLatestPaymentOverdue :one
+SELECT * FROM payment_overdues
+WHERE payment_id = $1
+ORDER BY calculated_at DESC, created_at DESC
+LIMIT 1;
+
+-- name: InsertPaymentOverdue :exec
+INSERT INTO payment_overdues (
+    id, payment_id, is_overdue, days_overdue, penalty, penalty_currency, calculated_at, carry
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (id) DO NOTHING;
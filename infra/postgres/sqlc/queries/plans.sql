@@ -0,0 +1,33 @@
+-- name: GetPlan :one
+SELECT * FROM plans
+WHERE id = $1;
+
+-- name: GetPlanIDByPaymentID :one
+SELECT plan_id FROM plan_installments
+WHERE payment_id = $1;
+
+-- name: InsertPlan :execrows
+INSERT INTO plans (
+    id, user_id, total, currency, frequency, remainder_placement, default_after_days, status, created_at, updated_at, version
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 1)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: UpdatePlan :execrows
+UPDATE plans
+SET status = $2,
+    updated_at = $3,
+    version = version + 1
+WHERE id = $1 AND version = $4;
+
+-- name: ListPlanInstallments :many
+SELECT * FROM plan_installments
+WHERE plan_id = $1
+ORDER BY seq;
+
+-- name: UpsertPlanInstallment :exec
+INSERT INTO plan_installments (
+    plan_id, seq, payment_id, amount, due_date, paid
+)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (plan_id, seq) DO UPDATE SET paid = EXCLUDED.paid;
@@ -0,0 +1,15 @@
+-- name: ListRateTableEntries :many
+SELECT * FROM rate_tables
+WHERE product = $1 AND currency = $2
+ORDER BY effective_from;
+
+-- name: InsertRateTableEntry :exec
+INSERT INTO rate_tables (
+    id, product, currency, effective_from, effective_to, daily_rate, created_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: CloseRateTableEntry :execrows
+UPDATE rate_tables
+SET effective_to = $2
+WHERE id = $1 AND (effective_to IS NULL OR effective_to > $2);
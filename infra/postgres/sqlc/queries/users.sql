@@ -0,0 +1,37 @@
+-- name: GetUser :one
+SELECT * FROM users
+WHERE id = $1;
+
+-- name: GetUserByExternalRef :one
+SELECT * FROM users
+WHERE external_ref = $1;
+
+-- name: GetUserForUpdate :one
+SELECT * FROM users
+WHERE id = $1
+FOR UPDATE;
+
+-- name: InsertUser :execrows
+INSERT INTO users (
+    id, name, external_ref, credit_limit, credit_currency, status,
+    email_enc, phone_enc, preferred_language, notification_opt_ins,
+    created_at, updated_at, version
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, 1)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: UpdateUser :execrows
+UPDATE users
+SET name = $2,
+    external_ref = $3,
+    credit_limit = $4,
+    credit_currency = $5,
+    status = $6,
+    email_enc = $7,
+    phone_enc = $8,
+    preferred_language = $9,
+    notification_opt_ins = $10,
+    anonymized_at = $11,
+    updated_at = $12,
+    version = version + 1
+WHERE id = $1 AND version = $13;
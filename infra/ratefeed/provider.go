@@ -0,0 +1,150 @@
+package ratefeed
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrRateMissing means the feed has no observation usable for the date.
+	ErrRateMissing     = errors.New("reference rate missing for date")
+	ErrInvalidProvider = errors.New("invalid reference rate provider")
+)
+
+// MissingPolicy decides what happens when the feed has no observation for
+// the requested date.
+type MissingPolicy string
+
+const (
+	// MissingUseLastKnown applies the latest earlier observation; the default,
+	// as reference rates are only published when they change or on business days.
+	MissingUseLastKnown MissingPolicy = "LAST_KNOWN"
+	// MissingFail requires an observation for the exact date.
+	MissingFail MissingPolicy = "FAIL"
+)
+
+var bpsPerPercent = decimal.NewFromInt(100)
+
+// Provider prices a contract at the reference rate plus a fixed spread,
+// converted to a daily rate with the configured day-count convention.
+type Provider struct {
+	series    *Series
+	spreadBPS decimal.Decimal
+	dayCount  money.DayCount
+	missing   MissingPolicy
+	// maxStaleDays bounds how old a last-known observation may be; zero means unbounded.
+	maxStaleDays int
+}
+
+// Option customizes a Provider.
+type Option func(*Provider)
+
+// WithSpreadBPS adds the contract spread in (possibly fractional) basis
+// points per year, e.g. 450 for reference + 4.5%p.
+func WithSpreadBPS(bps decimal.Decimal) Option {
+	return func(p *Provider) {
+		p.spreadBPS = bps
+	}
+}
+
+func WithDayCount(dc money.DayCount) Option {
+	return func(p *Provider) {
+		p.dayCount = dc
+	}
+}
+
+func WithMissingPolicy(policy MissingPolicy) Option {
+	return func(p *Provider) {
+		p.missing = policy
+	}
+}
+
+// WithMaxStaleDays makes MissingUseLastKnown fail once the last observation is
+// more than days old, e.g. when the feed stopped updating.
+func WithMaxStaleDays(days int) Option {
+	return func(p *Provider) {
+		p.maxStaleDays = days
+	}
+}
+
+func NewProvider(series *Series, opts ...Option) (*Provider, error) {
+	p := &Provider{
+		series:   series,
+		dayCount: money.DayCountACT365,
+		missing:  MissingUseLastKnown,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.series == nil || !p.dayCount.IsValid() || p.maxStaleDays < 0 {
+		return nil, ErrInvalidProvider
+	}
+	if p.missing != MissingUseLastKnown && p.missing != MissingFail {
+		return nil, ErrInvalidProvider
+	}
+	return p, nil
+}
+
+// AnnualPercent returns reference plus spread in effect on the date.
+func (p *Provider) AnnualPercent(at time.Time) (decimal.Decimal, error) {
+	day := truncateToDate(at)
+	obs, ok := p.series.LastOnOrBefore(day)
+	if !ok {
+		return decimal.Decimal{}, p.missingErr(day, "before first observation")
+	}
+	if !obs.Date.Equal(day) {
+		if p.missing == MissingFail {
+			return decimal.Decimal{}, p.missingErr(day, "no observation for the date")
+		}
+		if p.maxStaleDays > 0 && day.Sub(obs.Date) > time.Duration(p.maxStaleDays)*24*time.Hour {
+			return decimal.Decimal{}, p.missingErr(day, fmt.Sprintf("last observation %s is stale", obs.Date.Format(dateLayout)))
+		}
+	}
+	return obs.Percent.Add(p.spreadBPS.Div(bpsPerPercent)), nil
+}
+
+// DailyRate converts the annual rate on the date with the day-count
+// convention; a negative annual rate fails with money.ErrInvalidRate.
+func (p *Provider) DailyRate(at time.Time) (money.Rate, error) {
+	annual, err := p.AnnualPercent(at)
+	if err != nil {
+		return money.Rate{}, err
+	}
+	return p.dayCount.DailyRate(annual, at)
+}
+
+// ResolveDailyRate prices each accrual day from the observation in effect on
+// q.Date and that day's day-count year, so a window spanning a rate change or
+// a leap year boundary under ACT/ACT is priced day by day. The source reads
+// "ratefeed:<series name>".
+func (p *Provider) ResolveDailyRate(q payment.RateQuery) (payment.AppliedRate, error) {
+	rate, err := p.DailyRate(q.Date)
+	if err != nil {
+		return payment.AppliedRate{}, err
+	}
+	return payment.AppliedRate{Rate: rate, Source: "ratefeed:" + p.series.Name()}, nil
+}
+
+// DailyRateBPS reports the daily rate rounded to whole basis points.
+func (p *Provider) DailyRateBPS(at time.Time) (int64, error) {
+	rate, err := p.DailyRate(at)
+	if err != nil {
+		return 0, err
+	}
+	return rate.BPS().Round(0).IntPart(), nil
+}
+
+func (p *Provider) missingErr(day time.Time, reason string) error {
+	return fmt.Errorf("%w: %s on %s: %s", ErrRateMissing, p.series.Name(), day.Format(dateLayout), reason)
+}
+
+var (
+	_ payment.DailyRateProvider = (*Provider)(nil)
+	_ payment.DailyRater        = (*Provider)(nil)
+	_ payment.RateResolver      = (*Provider)(nil)
+)
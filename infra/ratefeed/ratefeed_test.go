@@ -0,0 +1,129 @@
+package ratefeed
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_CSVAndJSON(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "bok.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte("rate,date\n3.25,2024-02-01\n3.50,2024-01-01\n"), 0o600))
+	jsonPath := filepath.Join(dir, "bok.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`[{"date":"2024-01-01","rate":"3.50"},{"date":"2024-02-01","rate":3.25}]`), 0o600))
+
+	for _, path := range []string{csvPath, jsonPath} {
+		series, err := Load(path)
+		require.NoError(t, err)
+		obs, ok := series.LastOnOrBefore(time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC))
+		require.True(t, ok)
+		require.Equal(t, "3.5", obs.Percent.String())
+		require.Equal(t, filepath.Base(path), series.Name())
+	}
+
+	_, err := ParseCSV(strings.NewReader("day,rate\n2024-01-01,3.5\n"), "bad")
+	require.ErrorIs(t, err, ErrMissingColumn)
+	_, err = ParseCSV(strings.NewReader("date,rate\n2024-01-01,3.5\n2024-01-01,3.6\n"), "dup")
+	require.ErrorIs(t, err, ErrDuplicateDate)
+	_, err = Load(filepath.Join(dir, "bok.xml"))
+	require.Error(t, err)
+}
+
+func TestProvider_ReferencePlusSpread(t *testing.T) {
+	series := mustSeries(t)
+	provider, err := NewProvider(series,
+		WithSpreadBPS(decimal.NewFromInt(450)),
+		WithDayCount(money.DayCountACT360),
+	)
+	require.NoError(t, err)
+
+	annual, err := provider.AnnualPercent(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, "8", annual.String()) // 3.5% + 450bps, last known from Jan 1
+
+	rate, err := provider.DailyRate(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	expected, err := money.DailyRateFromAPR(decimal.NewFromInt(8), 360)
+	require.NoError(t, err)
+	require.True(t, rate.Equal(expected))
+
+	bps, err := provider.DailyRateBPS(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), bps) // 7.75% / 360 ≈ 2.15bps
+}
+
+func TestProvider_MissingDates(t *testing.T) {
+	series := mustSeries(t)
+	jan15 := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	strict, err := NewProvider(series, WithMissingPolicy(MissingFail))
+	require.NoError(t, err)
+	_, err = strict.DailyRate(jan15)
+	require.ErrorIs(t, err, ErrRateMissing)
+	require.ErrorContains(t, err, "bok on 2024-01-15")
+	_, err = strict.DailyRate(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	lenient, err := NewProvider(series, WithMaxStaleDays(30))
+	require.NoError(t, err)
+	_, err = lenient.DailyRate(jan15)
+	require.NoError(t, err)
+	_, err = lenient.DailyRate(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, err, ErrRateMissing)
+	_, err = lenient.DailyRate(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, err, ErrRateMissing)
+
+	_, err = NewProvider(series, WithMissingPolicy("SKIP"))
+	require.ErrorIs(t, err, ErrInvalidProvider)
+}
+
+func TestProvider_ResolvesEachDayAcrossChangeAndYearEnd(t *testing.T) {
+	series, err := NewSeries("bok",
+		Observation{Date: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC), Percent: decimal.RequireFromString("3.65")},
+		Observation{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Percent: decimal.RequireFromString("3.66")},
+	)
+	require.NoError(t, err)
+	provider, err := NewProvider(series, WithDayCount(money.DayCountACTACT))
+	require.NoError(t, err)
+
+	due := time.Date(2023, 12, 30, 0, 0, 0, 0, time.UTC)
+	u, err := user.New("tester", due)
+	require.NoError(t, err)
+	amount, err := money.FromMinor(1_000_000, money.CurrencyKRW)
+	require.NoError(t, err)
+	p, err := payment.New(u.ID(), amount, due, due)
+	require.NoError(t, err)
+
+	quote, err := p.QuoteAtResolved(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), provider)
+	require.NoError(t, err)
+	require.Len(t, quote.Days, 4)
+
+	oneBP := money.RateFromBPS(1)
+	// Dec 31 divides 3.65% by 365; Jan 1 still prices 3.65% but over a 366-day
+	// year; from Jan 2 the new 3.66% observation applies.
+	jan1, err := money.DailyRateFromAPR(decimal.RequireFromString("3.65"), 366)
+	require.NoError(t, err)
+	for i, want := range []money.Rate{oneBP, jan1, oneBP, oneBP} {
+		require.True(t, want.Equal(quote.Days[i].Rate), "day %s", quote.Days[i].Date.Format(dateLayout))
+		require.Equal(t, "ratefeed:bok", quote.Days[i].RateSource)
+	}
+}
+
+func mustSeries(t *testing.T) *Series {
+	t.Helper()
+	series, err := NewSeries("bok",
+		Observation{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Percent: decimal.RequireFromString("3.50")},
+		Observation{Date: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Percent: decimal.RequireFromString("3.25")},
+	)
+	require.NoError(t, err)
+	return series
+}
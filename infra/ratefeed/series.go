@@ -0,0 +1,186 @@
+// Package ratefeed loads a reference rate series (e.g. the BOK base rate)
+// from a local file and prices contracts as reference rate plus spread.
+//
+// CSV files need a header (order free), rate being an annual percentage:
+//
+//	date,rate
+//	2024-01-01,3.50
+//
+// JSON files hold an array of the same fields:
+//
+//	[{"date":"2024-01-01","rate":"3.50"}]
+package ratefeed
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const dateLayout = "2006-01-02"
+
+var (
+	ErrMissingColumn     = errors.New("rate feed missing required column")
+	ErrUnsupportedFormat = errors.New("unsupported rate feed format")
+	ErrDuplicateDate     = errors.New("rate feed lists a date twice")
+	ErrEmptySeries       = errors.New("rate feed has no observations")
+)
+
+// Observation is the annual reference rate published for a date.
+type Observation struct {
+	Date    time.Time
+	Percent decimal.Decimal
+}
+
+// Series is a reference rate history ordered by date.
+type Series struct {
+	name         string
+	observations []Observation
+}
+
+// NewSeries orders the observations by date, truncated to UTC days.
+func NewSeries(name string, observations ...Observation) (*Series, error) {
+	if len(observations) == 0 {
+		return nil, ErrEmptySeries
+	}
+	obs := make([]Observation, len(observations))
+	for i, o := range observations {
+		obs[i] = Observation{Date: truncateToDate(o.Date), Percent: o.Percent}
+	}
+	sort.Slice(obs, func(i, j int) bool { return obs[i].Date.Before(obs[j].Date) })
+	for i := 1; i < len(obs); i++ {
+		if obs[i].Date.Equal(obs[i-1].Date) {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateDate, obs[i].Date.Format(dateLayout))
+		}
+	}
+	return &Series{name: name, observations: obs}, nil
+}
+
+// Name identifies the series, by default the file name it was loaded from.
+func (s *Series) Name() string {
+	return s.name
+}
+
+// LastOnOrBefore returns the latest observation not after date.
+func (s *Series) LastOnOrBefore(date time.Time) (Observation, bool) {
+	date = truncateToDate(date)
+	idx := sort.Search(len(s.observations), func(i int) bool {
+		return s.observations[i].Date.After(date)
+	})
+	if idx == 0 {
+		return Observation{}, false
+	}
+	return s.observations[idx-1], true
+}
+
+// Load reads a .csv or .json file, naming the series after the file.
+func Load(path string) (*Series, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	name := filepath.Base(path)
+	var series *Series
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		series, err = ParseCSV(f, name)
+	case ".json":
+		series, err = ParseJSON(f, name)
+	default:
+		err = ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return series, nil
+}
+
+// ParseCSV decodes date,rate rows.
+func ParseCSV(r io.Reader, name string) (*Series, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	cols := make(map[string]int, len(header))
+	for i, col := range header {
+		cols[strings.ToLower(strings.TrimSpace(col))] = i
+	}
+	for _, col := range []string{"date", "rate"} {
+		if _, ok := cols[col]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, col)
+		}
+	}
+
+	var observations []Observation
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return NewSeries(name, observations...)
+		}
+		if err != nil {
+			return nil, err
+		}
+		obs, err := parseObservation(record[cols["date"]], record[cols["rate"]])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		observations = append(observations, obs)
+	}
+}
+
+type observationJSON struct {
+	Date string          `json:"date"`
+	Rate json.RawMessage `json:"rate"`
+}
+
+// ParseJSON decodes an array of {"date","rate"} objects; rate may be a
+// string or a number.
+func ParseJSON(r io.Reader, name string) (*Series, error) {
+	var raw []observationJSON
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+	observations := make([]Observation, 0, len(raw))
+	for i, item := range raw {
+		if item.Date == "" || len(item.Rate) == 0 {
+			return nil, fmt.Errorf("entry %d: %w: date, rate", i, ErrMissingColumn)
+		}
+		obs, err := parseObservation(item.Date, strings.Trim(string(item.Rate), `"`))
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		observations = append(observations, obs)
+	}
+	return NewSeries(name, observations...)
+}
+
+func parseObservation(date, rate string) (Observation, error) {
+	day, err := time.Parse(dateLayout, strings.TrimSpace(date))
+	if err != nil {
+		return Observation{}, err
+	}
+	percent, err := decimal.NewFromString(strings.TrimSpace(rate))
+	if err != nil {
+		return Observation{}, err
+	}
+	return Observation{Date: day, Percent: percent}, nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.In(time.UTC).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,64 @@
+package credit
+
+import (
+	"context"
+
+	dcredit "github.com/jaeyoung0509/compound-interest/domain/credit"
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+)
+
+// Service answers credit questions about users before new purchases start.
+type Service struct {
+	users    user.Repository
+	payments payment.UserPayments
+	clock    payment.Clock
+	policy   dcredit.Policy
+}
+
+// Option customizes the credit service.
+type Option func(*Service)
+
+// WithPolicy overrides the default blocking thresholds.
+func WithPolicy(policy dcredit.Policy) Option {
+	return func(s *Service) {
+		s.policy = policy
+	}
+}
+
+func NewService(users user.Repository, payments payment.UserPayments, clock payment.Clock, opts ...Option) *Service {
+	s := &Service{
+		users:    users,
+		payments: payments,
+		clock:    clock,
+		policy:   dcredit.DefaultPolicy,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Profile computes the user's current limit, exposure and blocked flag.
+func (s *Service) Profile(ctx context.Context, userID user.ID) (dcredit.Profile, error) {
+	u, err := s.users.Get(ctx, userID)
+	if err != nil {
+		return dcredit.Profile{}, err
+	}
+	payments, err := s.payments.ListByUser(ctx, userID)
+	if err != nil {
+		return dcredit.Profile{}, err
+	}
+	return dcredit.NewProfile(u, payments, s.policy, s.clock.Now())
+}
+
+// CheckPurchaseEligibility approves or declines a new purchase of amount,
+// listing every reason for a decline.
+func (s *Service) CheckPurchaseEligibility(ctx context.Context, userID user.ID, amount money.Money) (dcredit.Decision, error) {
+	profile, err := s.Profile(ctx, userID)
+	if err != nil {
+		return dcredit.Decision{}, err
+	}
+	return profile.Evaluate(amount)
+}
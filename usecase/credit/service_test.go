@@ -0,0 +1,58 @@
+package credit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dcredit "github.com/jaeyoung0509/compound-interest/domain/credit"
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	dp "github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	upayment "github.com/jaeyoung0509/compound-interest/usecase/payment"
+	uuser "github.com/jaeyoung0509/compound-interest/usecase/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPurchaseEligibility_DeclinesOverdueUser(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := uuser.NewInMemoryUserRepo()
+	payments := upayment.NewInMemoryPaymentRepo()
+
+	u, err := user.New("tester", base, user.WithCreditLimit(mustKRW(t, 100_000)))
+	require.NoError(t, err)
+	require.NoError(t, u.VerifyKYC(base))
+	require.NoError(t, users.Save(context.Background(), u))
+
+	for i := 0; i < 3; i++ {
+		p, err := dp.New(u.ID(), mustKRW(t, 10_000), base, base)
+		require.NoError(t, err)
+		require.NoError(t, payments.Save(context.Background(), p))
+	}
+
+	svc := NewService(users, payments, dp.FixedClock{NowTime: base.AddDate(0, 0, 5)})
+	decision, err := svc.CheckPurchaseEligibility(context.Background(), u.ID(), mustKRW(t, 10_000))
+	require.NoError(t, err)
+	require.True(t, decision.Approved)
+	require.Equal(t, 3, decision.Profile.OverduePayments)
+	require.Equal(t, "30000", decision.Profile.Exposure.Amount().String())
+
+	strict := NewService(users, payments, dp.FixedClock{NowTime: base.AddDate(0, 0, 5)},
+		WithPolicy(dcredit.Policy{BlockAfterDaysOverdue: 5}))
+	decision, err = strict.CheckPurchaseEligibility(context.Background(), u.ID(), mustKRW(t, 10_000))
+	require.NoError(t, err)
+	require.False(t, decision.Approved)
+	require.Equal(t, []dcredit.Reason{dcredit.ReasonOverdueBlocked}, decision.Reasons)
+}
+
+func TestCheckPurchaseEligibility_UnknownUser(t *testing.T) {
+	svc := NewService(uuser.NewInMemoryUserRepo(), upayment.NewInMemoryPaymentRepo(), dp.FixedClock{NowTime: time.Now()})
+	_, err := svc.CheckPurchaseEligibility(context.Background(), user.NewID(), mustKRW(t, 1_000))
+	require.ErrorIs(t, err, user.ErrUserNotFound)
+}
+
+func mustKRW(t *testing.T, minor int64) money.Money {
+	m, err := money.FromMinor(minor, money.CurrencyKRW)
+	require.NoError(t, err)
+	return m
+}
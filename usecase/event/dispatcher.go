@@ -0,0 +1,46 @@
+package event
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+)
+
+// Handler reacts to one published domain event.
+type Handler func(ctx context.Context, evt shared.DomainEvent) error
+
+// Dispatcher is an in-process Publisher that hands every event to each
+// subscribed handler in subscription order, stopping at the first error.
+type Dispatcher struct {
+	mu       sync.RWMutex
+	handlers []Handler
+}
+
+func NewDispatcher(handlers ...Handler) *Dispatcher {
+	return &Dispatcher{handlers: handlers}
+}
+
+// Subscribe adds h to the handlers of every later Publish.
+func (d *Dispatcher) Subscribe(h Handler) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handlers = append(d.handlers, h)
+}
+
+func (d *Dispatcher) Publish(ctx context.Context, events ...shared.DomainEvent) error {
+	d.mu.RLock()
+	handlers := d.handlers
+	d.mu.RUnlock()
+
+	for _, evt := range events {
+		for _, h := range handlers {
+			if err := h(ctx, evt); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+var _ Publisher = (*Dispatcher)(nil)
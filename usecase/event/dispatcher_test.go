@@ -0,0 +1,48 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	id string
+}
+
+func (e testEvent) EventType() string     { return "test.happened" }
+func (e testEvent) AggregateType() string { return "test" }
+func (e testEvent) AggregateID() string   { return e.id }
+func (e testEvent) OccurredAt() time.Time { return time.Time{} }
+
+func TestDispatcher_DeliversToSubscribersInOrder(t *testing.T) {
+	var seen []string
+	record := func(name string) Handler {
+		return func(_ context.Context, evt shared.DomainEvent) error {
+			seen = append(seen, name+":"+evt.AggregateID())
+			return nil
+		}
+	}
+
+	d := NewDispatcher(record("a"))
+	d.Subscribe(record("b"))
+	require.NoError(t, d.Publish(context.Background(), testEvent{id: "1"}, testEvent{id: "2"}))
+	require.Equal(t, []string{"a:1", "b:1", "a:2", "b:2"}, seen)
+}
+
+func TestDispatcher_StopsAtFirstError(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	d := NewDispatcher(
+		func(context.Context, shared.DomainEvent) error { return boom },
+		func(context.Context, shared.DomainEvent) error { calls++; return nil },
+	)
+
+	err := d.Publish(context.Background(), testEvent{id: "1"})
+	require.ErrorIs(t, err, boom)
+	require.Zero(t, calls)
+}
@@ -2,16 +2,20 @@ package payment
 
 import (
 	"context"
+	"maps"
+	"sort"
 	"sync"
 
 	dp "github.com/jaeyoung0509/compound-interest/domain/payment"
 	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
 )
 
 // InMemoryPaymentRepo is a simple fake repository for tests and local usage.
 type InMemoryPaymentRepo struct {
 	mu       sync.Mutex
 	store    map[shared.ID]*dp.Payment
+	ledger   map[shared.ID][]dp.AccrualLine
 	GetErr   error
 	SaveErr  error
 	saveHits int
@@ -19,16 +23,17 @@ type InMemoryPaymentRepo struct {
 
 func NewInMemoryPaymentRepo() *InMemoryPaymentRepo {
 	return &InMemoryPaymentRepo{
-		store: make(map[shared.ID]*dp.Payment),
+		store:  make(map[shared.ID]*dp.Payment),
+		ledger: make(map[shared.ID][]dp.AccrualLine),
 	}
 }
 
-// Seed primes the repository with given payments.
+// Seed primes the repository with given payments as if they had been loaded from storage.
 func (r *InMemoryPaymentRepo) Seed(payments ...*dp.Payment) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	for _, p := range payments {
-		r.store[p.ID()] = p
+		r.store[p.ID()] = detach(p)
 	}
 }
 
@@ -42,7 +47,29 @@ func (r *InMemoryPaymentRepo) Get(ctx context.Context, id shared.ID) (*dp.Paymen
 	if !ok {
 		return nil, dp.ErrPaymentNotFound
 	}
-	return p, nil
+	return detach(p), nil
+}
+
+func (r *InMemoryPaymentRepo) ListByUser(ctx context.Context, userID user.ID) ([]*dp.Payment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.GetErr != nil {
+		return nil, r.GetErr
+	}
+	var payments []*dp.Payment
+	for _, p := range r.store {
+		if p.UserID() == userID {
+			payments = append(payments, detach(p))
+		}
+	}
+	// Match Postgres: ORDER BY due_date, id.
+	sort.SliceStable(payments, func(i, j int) bool {
+		if !payments[i].DueDate().Equal(payments[j].DueDate()) {
+			return payments[i].DueDate().Before(payments[j].DueDate())
+		}
+		return payments[i].ID().String() < payments[j].ID().String()
+	})
+	return payments, nil
 }
 
 func (r *InMemoryPaymentRepo) Save(ctx context.Context, payment *dp.Payment) error {
@@ -51,13 +78,56 @@ func (r *InMemoryPaymentRepo) Save(ctx context.Context, payment *dp.Payment) err
 	if r.SaveErr != nil {
 		return r.SaveErr
 	}
-	r.store[payment.ID()] = payment
+	if stored, ok := r.store[payment.ID()]; ok && stored.Version() != payment.Version() {
+		return dp.ErrConcurrentModification
+	}
+	r.ledger[payment.ID()] = append(r.ledger[payment.ID()], payment.PullAccrualLines()...)
+	payment.IncrementVersion()
+	r.store[payment.ID()] = detach(payment)
 	r.saveHits++
 	return nil
 }
 
+// Checkpoint captures the stored payments and ledger; calling restore rolls
+// the repository back to them, as an in-memory unit of work does on failure.
+func (r *InMemoryPaymentRepo) Checkpoint() (restore func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	store := maps.Clone(r.store)
+	ledger := maps.Clone(r.ledger)
+	saveHits := r.saveHits
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.store, r.ledger, r.saveHits = store, ledger, saveHits
+	}
+}
+
 func (r *InMemoryPaymentRepo) SaveCount() int {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	return r.saveHits
 }
+
+func (r *InMemoryPaymentRepo) ListAccrualLines(ctx context.Context, paymentID shared.ID) ([]dp.AccrualLine, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lines := make([]dp.AccrualLine, len(r.ledger[paymentID]))
+	copy(lines, r.ledger[paymentID])
+	return lines, nil
+}
+
+// detach copies p without its pending events and accrual lines: those belong
+// to the caller, and sharing them would replay events on every Get.
+func detach(p *dp.Payment) *dp.Payment {
+	cloned := *p
+	cloned.PullEvents()
+	cloned.PullAccrualLines()
+	return &cloned
+}
+
+var (
+	_ dp.Repository    = (*InMemoryPaymentRepo)(nil)
+	_ dp.AccrualLedger = (*InMemoryPaymentRepo)(nil)
+	_ dp.UserPayments  = (*InMemoryPaymentRepo)(nil)
+)
@@ -0,0 +1,22 @@
+package payment
+
+import (
+	"context"
+
+	dp "github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+)
+
+// AccrualLedgerQuery serves the per-day accrual breakdown for statements and support tools.
+type AccrualLedgerQuery struct {
+	ledger dp.AccrualLedger
+}
+
+func NewAccrualLedgerQuery(ledger dp.AccrualLedger) *AccrualLedgerQuery {
+	return &AccrualLedgerQuery{ledger: ledger}
+}
+
+// Lines returns the accrual history of a payment ordered by date.
+func (q *AccrualLedgerQuery) Lines(ctx context.Context, paymentID shared.ID) ([]dp.AccrualLine, error) {
+	return q.ledger.ListAccrualLines(ctx, paymentID)
+}
@@ -2,40 +2,204 @@ package payment
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/jaeyoung0509/compound-interest/domain/money"
 	dp "github.com/jaeyoung0509/compound-interest/domain/payment"
 	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/usecase/event"
 )
 
+const defaultMaxRetries = 3
+
+// ErrUserRepositoryRequired is returned by CreatePayment on a service wired
+// without WithUserRepository, as the owner's status cannot be checked.
+var ErrUserRepositoryRequired = errors.New("payment service has no user repository")
+
+// errUnchanged lets an update callback skip the save when nothing changed.
+var errUnchanged = errors.New("payment unchanged")
+
 // Service orchestrates payment accrual with injected dependencies.
 type Service struct {
 	repo         dp.Repository
 	clock        dp.Clock
 	rateProvider dp.DailyRateProvider
+	resolver     dp.RateResolver
+	earlyPolicy  dp.EarlyPaymentPolicy
+	users        user.Repository
+	uow          UnitOfWork
+	maxRetries   int
+}
+
+// Option customizes a Service.
+type Option func(*Service)
+
+// WithMaxRetries bounds how often a mutation is reloaded and reapplied after
+// ErrConcurrentModification. Zero disables retries.
+func WithMaxRetries(n int) Option {
+	return func(s *Service) {
+		if n >= 0 {
+			s.maxRetries = n
+		}
+	}
+}
+
+// WithEarlyPaymentPolicy decides how PayPayment treats payments before the
+// due date. By default they are accepted at face value.
+func WithEarlyPaymentPolicy(policy dp.EarlyPaymentPolicy) Option {
+	return func(s *Service) {
+		if policy != nil {
+			s.earlyPolicy = policy
+		}
+	}
 }
 
-func NewService(repo dp.Repository, clock dp.Clock, rateProvider dp.DailyRateProvider) *Service {
-	return &Service{
+// WithUserRepository lets AccruePayment consult the owner's status and skip
+// accrual for accounts whose interest is frozen (see user.Status.AccruesInterest).
+// CreatePayment requires it.
+func WithUserRepository(users user.Repository) Option {
+	return func(s *Service) {
+		s.users = users
+	}
+}
+
+// WithRateResolver prices each accrual day from the payment context (user,
+// product, days overdue), taking precedence over the flat rate provider.
+func WithRateResolver(resolver dp.RateResolver) Option {
+	return func(s *Service) {
+		s.resolver = resolver
+	}
+}
+
+// WithUnitOfWork saves payments through uow and publishes the events they
+// raised in the same transaction. Without it events are dropped.
+func WithUnitOfWork(uow UnitOfWork) Option {
+	return func(s *Service) {
+		s.uow = uow
+	}
+}
+
+func NewService(repo dp.Repository, clock dp.Clock, rateProvider dp.DailyRateProvider, opts ...Option) *Service {
+	s := &Service{
 		repo:         repo,
 		clock:        clock,
 		rateProvider: rateProvider,
+		earlyPolicy:  dp.FaceValueEarlyPayment{},
+		maxRetries:   defaultMaxRetries,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.uow == nil {
+		s.uow = NewInMemoryUnitOfWork(repo, event.NoopPublisher{})
 	}
+	return s
 }
 
-// AccruePayment loads a payment, accrues interest using the injected collaborators, and persists the result.
-func (s *Service) AccruePayment(ctx context.Context, id shared.ID) (*dp.Payment, error) {
-	p, err := s.repo.Get(ctx, id)
+// CreatePayment schedules a new payment for the user and persists it. Only
+// ACTIVE users may take new payments; pending, suspended and closed users fail
+// with user.ErrUserNotActive (see user.Status.CanBorrow).
+func (s *Service) CreatePayment(ctx context.Context, userID user.ID, amount money.Money, dueDate time.Time, opts ...dp.Option) (*dp.Payment, error) {
+	if s.users == nil {
+		return nil, ErrUserRepositoryRequired
+	}
+	u, err := s.users.Get(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	if !u.Status().CanBorrow() {
+		return nil, user.ErrUserNotActive
+	}
 
-	if err := p.AccrueInterestWith(s.clock, s.rateProvider); err != nil {
+	p, err := dp.New(userID, amount, dueDate, s.clock.Now(), opts...)
+	if err != nil {
 		return nil, err
 	}
-
-	if err := s.repo.Save(ctx, p); err != nil {
+	err = s.uow.Do(ctx, func(payments dp.Repository, events event.Publisher) error {
+		if err := payments.Save(ctx, p); err != nil {
+			return err
+		}
+		return events.Publish(ctx, p.PullEvents()...)
+	})
+	if err != nil {
 		return nil, err
 	}
-
 	return p, nil
 }
+
+// AccruePayment loads a payment, accrues interest using the injected
+// collaborators, and persists the result. Payments of frozen users are
+// returned unchanged.
+func (s *Service) AccruePayment(ctx context.Context, id shared.ID) (*dp.Payment, error) {
+	return s.update(ctx, id, func(p *dp.Payment) error {
+		if s.users != nil {
+			u, err := s.users.Get(ctx, p.UserID())
+			if err != nil {
+				return err
+			}
+			if !u.Status().AccruesInterest() {
+				return errUnchanged
+			}
+		}
+		if s.resolver != nil {
+			return p.AccrueInterestResolved(s.clock.Now(), s.resolver)
+		}
+		return p.AccrueInterestWith(s.clock, s.rateProvider)
+	})
+}
+
+// PayPayment marks a payment as paid at the current clock time, applying the
+// configured early payment policy.
+func (s *Service) PayPayment(ctx context.Context, id shared.ID) (*dp.Payment, error) {
+	return s.update(ctx, id, func(p *dp.Payment) error {
+		return p.PayWith(s.clock.Now(), s.earlyPolicy)
+	})
+}
+
+// QuotePayoff projects the amount owed on the given date without persisting anything.
+func (s *Service) QuotePayoff(ctx context.Context, id shared.ID, date time.Time) (dp.Quote, error) {
+	p, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return dp.Quote{}, err
+	}
+	if s.resolver != nil {
+		return p.QuoteAtResolved(date, s.resolver)
+	}
+	return p.QuoteAt(date, s.rateProvider)
+}
+
+// update runs Get → apply → Save, reloading and reapplying when another
+// writer saved the payment in between. The events raised by apply are
+// published in the unit of work that saves the payment.
+func (s *Service) update(ctx context.Context, id shared.ID, apply func(*dp.Payment) error) (*dp.Payment, error) {
+	var err error
+	for attempt := 0; attempt <= s.maxRetries; attempt++ {
+		var p *dp.Payment
+		p, err = s.repo.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := apply(p); errors.Is(err, errUnchanged) {
+			return p, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		err = s.uow.Do(ctx, func(payments dp.Repository, events event.Publisher) error {
+			if err := payments.Save(ctx, p); err != nil {
+				return err
+			}
+			return events.Publish(ctx, p.PullEvents()...)
+		})
+		if err == nil {
+			return p, nil
+		}
+		if !errors.Is(err, dp.ErrConcurrentModification) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
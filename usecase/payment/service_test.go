@@ -8,8 +8,11 @@ import (
 
 	"github.com/jaeyoung0509/compound-interest/domain/money"
 	dp "github.com/jaeyoung0509/compound-interest/domain/payment"
+	drate "github.com/jaeyoung0509/compound-interest/domain/rate"
 	"github.com/jaeyoung0509/compound-interest/domain/shared"
 	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/usecase/event"
+	uuser "github.com/jaeyoung0509/compound-interest/usecase/user"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,6 +42,54 @@ func TestAccruePayment_Success(t *testing.T) {
 	require.True(t, info.Penalty.Amount().Equal(expectedPenalty.Amount()))
 }
 
+func TestInMemoryPaymentRepo_DoesNotStoreEvents(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p, err := dp.New(mustUserID(t, base), mustKRW(t, 10_000), base, base)
+	require.NoError(t, err)
+	require.NoError(t, p.AccrueInterest(base.Add(24*time.Hour), 1_000))
+
+	repo := NewInMemoryPaymentRepo()
+	require.NoError(t, repo.Save(context.Background(), p))
+	require.Len(t, p.PullEvents(), 1)
+
+	loaded, err := repo.Get(context.Background(), p.ID())
+	require.NoError(t, err)
+	require.Empty(t, loaded.PullEvents())
+	require.Empty(t, loaded.PullAccrualLines())
+
+	require.NoError(t, loaded.Pay(base.Add(48*time.Hour)))
+	again, err := repo.Get(context.Background(), p.ID())
+	require.NoError(t, err)
+	require.Empty(t, again.PullEvents())
+	require.Equal(t, dp.StatusOverdue, again.Status())
+}
+
+func TestInMemoryPaymentRepo_ListByUserOrdersByDueDateThenID(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	ids := shared.NewSequentialIDGenerator(base)
+
+	repo := NewInMemoryPaymentRepo()
+	var want []shared.ID
+	for _, offset := range []int{7, 0, 0, 0, 7} {
+		p, err := dp.New(uid, mustKRW(t, 1_000), base.AddDate(0, 0, offset), base, dp.WithIDGenerator(ids))
+		require.NoError(t, err)
+		repo.Seed(p)
+		if offset == 0 {
+			want = append(want, p.ID())
+		}
+	}
+
+	for range 5 {
+		payments, err := repo.ListByUser(context.Background(), uid)
+		require.NoError(t, err)
+		require.Len(t, payments, 5)
+		got := []shared.ID{payments[0].ID(), payments[1].ID(), payments[2].ID()}
+		require.Equal(t, want, got)
+		require.True(t, payments[3].ID().String() < payments[4].ID().String())
+	}
+}
+
 func TestAccruePayment_PaidSkipsSave(t *testing.T) {
 	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 	uid := mustUserID(t, base)
@@ -87,6 +138,346 @@ func TestAccruePayment_SaveError(t *testing.T) {
 	require.Equal(t, 0, repo.SaveCount())
 }
 
+func TestQuotePayoff_DoesNotPersist(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := dp.New(uid, amt, base, base)
+	require.NoError(t, err)
+
+	repo := NewInMemoryPaymentRepo()
+	repo.Seed(p)
+
+	svc := NewService(repo, dp.FixedClock{NowTime: base}, dp.StaticDailyRate{BPS: 1_000})
+
+	quote, err := svc.QuotePayoff(context.Background(), p.ID(), base.Add(48*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2, quote.DaysOverdue)
+	require.True(t, quote.Total.Amount().Equal(mustKRW(t, 12_100).Amount()))
+	require.Equal(t, 0, repo.SaveCount())
+	require.Nil(t, p.OverdueInfo())
+}
+
+func TestAccrualLedgerQuery_ReturnsPersistedLines(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := dp.New(uid, amt, base, base)
+	require.NoError(t, err)
+
+	repo := NewInMemoryPaymentRepo()
+	repo.Seed(p)
+	ctx := context.Background()
+
+	first := NewService(repo, dp.FixedClock{NowTime: base.Add(24 * time.Hour)}, dp.StaticDailyRate{BPS: 1_000})
+	_, err = first.AccruePayment(ctx, p.ID())
+	require.NoError(t, err)
+
+	second := NewService(repo, dp.FixedClock{NowTime: base.Add(72 * time.Hour)}, dp.StaticDailyRate{BPS: 1_000})
+	_, err = second.AccruePayment(ctx, p.ID())
+	require.NoError(t, err)
+
+	lines, err := NewAccrualLedgerQuery(repo).Lines(ctx, p.ID())
+	require.NoError(t, err)
+	require.Len(t, lines, 3)
+	require.True(t, lines[2].Penalty.Amount().Equal(mustKRW(t, 3_310).Amount()))
+}
+
+func TestAccruePayment_RetriesAfterConcurrentSave(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := dp.New(uid, amt, base, base)
+	require.NoError(t, err)
+
+	repo := &racingRepo{InMemoryPaymentRepo: NewInMemoryPaymentRepo(), races: 1}
+	repo.Seed(p)
+	repo.race = func(ctx context.Context, id shared.ID) error {
+		// Another writer bumps the version without changing the outcome.
+		other, err := repo.InMemoryPaymentRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		return repo.InMemoryPaymentRepo.Save(ctx, other)
+	}
+
+	svc := NewService(repo, dp.FixedClock{NowTime: base.Add(48 * time.Hour)}, dp.StaticDailyRate{BPS: 1_000})
+
+	updated, err := svc.AccruePayment(context.Background(), p.ID())
+	require.NoError(t, err)
+	require.Equal(t, 2, repo.SaveCount())
+	require.Equal(t, int64(2), updated.Version())
+	require.Equal(t, 2, updated.OverdueInfo().DaysOverdue)
+}
+
+func TestAccruePayment_ConcurrentPayWins(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := dp.New(uid, amt, base, base)
+	require.NoError(t, err)
+
+	repo := &racingRepo{InMemoryPaymentRepo: NewInMemoryPaymentRepo(), races: 1}
+	repo.Seed(p)
+	payer := NewService(repo.InMemoryPaymentRepo, dp.FixedClock{NowTime: base.Add(time.Hour)}, dp.StaticDailyRate{})
+	repo.race = func(ctx context.Context, id shared.ID) error {
+		_, err := payer.PayPayment(ctx, id)
+		return err
+	}
+
+	svc := NewService(repo, dp.FixedClock{NowTime: base.Add(48 * time.Hour)}, dp.StaticDailyRate{BPS: 1_000})
+
+	_, err = svc.AccruePayment(context.Background(), p.ID())
+	require.ErrorIs(t, err, dp.ErrPaidPaymentCannotOverdue)
+
+	stored, err := repo.Get(context.Background(), p.ID())
+	require.NoError(t, err)
+	require.Equal(t, dp.StatusPaid, stored.Status())
+	require.Nil(t, stored.OverdueInfo())
+}
+
+func TestAccruePayment_GivesUpAfterMaxRetries(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := dp.New(uid, amt, base, base)
+	require.NoError(t, err)
+
+	repo := &racingRepo{InMemoryPaymentRepo: NewInMemoryPaymentRepo(), races: 10}
+	repo.Seed(p)
+	repo.race = func(ctx context.Context, id shared.ID) error {
+		other, err := repo.InMemoryPaymentRepo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		return repo.InMemoryPaymentRepo.Save(ctx, other)
+	}
+
+	svc := NewService(repo, dp.FixedClock{NowTime: base.Add(48 * time.Hour)}, dp.StaticDailyRate{BPS: 1_000}, WithMaxRetries(2))
+
+	_, err = svc.AccruePayment(context.Background(), p.ID())
+	require.ErrorIs(t, err, dp.ErrConcurrentModification)
+	require.Equal(t, 3, repo.SaveCount())
+}
+
+func TestPayPayment_AppliesEarlyPaymentPolicy(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+	amt := mustKRW(t, 10_000)
+
+	p, err := dp.New(uid, amt, base.AddDate(0, 0, 14), base)
+	require.NoError(t, err)
+
+	repo := NewInMemoryPaymentRepo()
+	repo.Seed(p)
+
+	strict := NewService(repo, dp.FixedClock{NowTime: base}, dp.StaticDailyRate{},
+		WithEarlyPaymentPolicy(dp.RejectEarlyPayment{}))
+	_, err = strict.PayPayment(context.Background(), p.ID())
+	require.ErrorIs(t, err, dp.ErrPaidBeforeDueDate)
+
+	lenient := NewService(repo, dp.FixedClock{NowTime: base}, dp.StaticDailyRate{},
+		WithEarlyPaymentPolicy(dp.DiscountEarlyPayment{BPS: 200, MinDaysEarly: 7}))
+	paid, err := lenient.PayPayment(context.Background(), p.ID())
+	require.NoError(t, err)
+	require.Equal(t, dp.StatusPaid, paid.Status())
+	require.True(t, paid.Discount().Amount().Equal(mustKRW(t, 200).Amount()))
+	require.Equal(t, 1, repo.SaveCount())
+}
+
+func TestPayPayment_AcceptsEarlyPaymentByDefault(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p, err := dp.New(mustUserID(t, base), mustKRW(t, 10_000), base.AddDate(0, 0, 14), base)
+	require.NoError(t, err)
+
+	repo := NewInMemoryPaymentRepo()
+	repo.Seed(p)
+
+	svc := NewService(repo, dp.FixedClock{NowTime: base}, dp.StaticDailyRate{})
+	paid, err := svc.PayPayment(context.Background(), p.ID())
+	require.NoError(t, err)
+	require.Equal(t, dp.StatusPaid, paid.Status())
+	require.True(t, paid.Discount().IsZero())
+}
+
+func TestPayPayment_PublishesEventsInUnitOfWork(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p, err := dp.New(mustUserID(t, base), mustKRW(t, 10_000), base, base)
+	require.NoError(t, err)
+
+	repo := NewInMemoryPaymentRepo()
+	repo.Seed(p)
+	var published []shared.DomainEvent
+	events := event.NewDispatcher(func(_ context.Context, evt shared.DomainEvent) error {
+		published = append(published, evt)
+		return nil
+	})
+
+	svc := NewService(repo, dp.FixedClock{NowTime: base}, dp.StaticDailyRate{},
+		WithUnitOfWork(NewInMemoryUnitOfWork(repo, events)))
+	paid, err := svc.PayPayment(context.Background(), p.ID())
+	require.NoError(t, err)
+	require.Empty(t, paid.PullEvents())
+	require.Len(t, published, 1)
+	require.Equal(t, dp.EventPaymentPaid, published[0].EventType())
+	require.Equal(t, p.ID().String(), published[0].AggregateID())
+}
+
+func TestPayPayment_FailsWhenPublishFails(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p, err := dp.New(mustUserID(t, base), mustKRW(t, 10_000), base, base)
+	require.NoError(t, err)
+
+	repo := NewInMemoryPaymentRepo()
+	repo.Seed(p)
+	outboxDown := errors.New("outbox down")
+	events := event.NewDispatcher(func(context.Context, shared.DomainEvent) error {
+		return outboxDown
+	})
+
+	svc := NewService(repo, dp.FixedClock{NowTime: base}, dp.StaticDailyRate{},
+		WithUnitOfWork(NewInMemoryUnitOfWork(repo, events)))
+	_, err = svc.PayPayment(context.Background(), p.ID())
+	require.ErrorIs(t, err, outboxDown)
+}
+
+// racingRepo lets another writer save between the service's Get and Save.
+type racingRepo struct {
+	*InMemoryPaymentRepo
+	races int
+	race  func(ctx context.Context, id shared.ID) error
+}
+
+func (r *racingRepo) Save(ctx context.Context, p *dp.Payment) error {
+	if r.races > 0 {
+		r.races--
+		if err := r.race(ctx, p.ID()); err != nil {
+			return err
+		}
+	}
+	return r.InMemoryPaymentRepo.Save(ctx, p)
+}
+
+func TestAccruePayment_FrozenForClosedUser(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := uuser.NewInMemoryUserRepo()
+	u, err := user.New("tester", base)
+	require.NoError(t, err)
+	require.NoError(t, u.VerifyKYC(base))
+	require.NoError(t, users.Save(context.Background(), u))
+
+	p, err := dp.New(u.ID(), mustKRW(t, 10_000), base, base)
+	require.NoError(t, err)
+	repo := NewInMemoryPaymentRepo()
+	repo.Seed(p)
+
+	svc := NewService(repo, dp.FixedClock{NowTime: base.Add(48 * time.Hour)}, dp.StaticDailyRate{BPS: 1_000},
+		WithUserRepository(users))
+
+	stored, err := users.Get(context.Background(), u.ID())
+	require.NoError(t, err)
+	require.NoError(t, stored.Close("deceased", base.Add(time.Hour)))
+	require.NoError(t, users.Save(context.Background(), stored))
+
+	frozen, err := svc.AccruePayment(context.Background(), p.ID())
+	require.NoError(t, err)
+	require.Nil(t, frozen.OverdueInfo())
+	require.Equal(t, 0, repo.SaveCount())
+}
+
+func TestAccruePayment_SuspendedUserStillAccrues(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := uuser.NewInMemoryUserRepo()
+	u, err := user.New("tester", base)
+	require.NoError(t, err)
+	require.NoError(t, u.VerifyKYC(base))
+	require.NoError(t, u.Suspend("fraud review", base))
+	require.NoError(t, users.Save(context.Background(), u))
+
+	p, err := dp.New(u.ID(), mustKRW(t, 10_000), base, base)
+	require.NoError(t, err)
+	repo := NewInMemoryPaymentRepo()
+	repo.Seed(p)
+
+	svc := NewService(repo, dp.FixedClock{NowTime: base.Add(48 * time.Hour)}, dp.StaticDailyRate{BPS: 1_000},
+		WithUserRepository(users))
+	updated, err := svc.AccruePayment(context.Background(), p.ID())
+	require.NoError(t, err)
+	require.Equal(t, 2, updated.OverdueInfo().DaysOverdue)
+}
+
+func TestAccruePayment_WithRateResolverUsesUserTier(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := mustUserID(t, base)
+
+	p, err := dp.New(uid, mustKRW(t, 10_000), base, base)
+	require.NoError(t, err)
+	repo := NewInMemoryPaymentRepo()
+	repo.Seed(p)
+
+	tiered, err := drate.NewTieredProvider(drate.StaticTiers{uid: "C"}, "",
+		drate.TierPricing{Product: dp.DefaultProduct.Code, Tier: "C", Base: money.RateFromBPS(500),
+			Escalations: []drate.Escalation{{AfterDays: 1, Rate: money.RateFromBPS(1_000)}}})
+	require.NoError(t, err)
+
+	svc := NewService(repo, dp.FixedClock{NowTime: base.Add(48 * time.Hour)}, dp.StaticDailyRate{BPS: 1},
+		WithRateResolver(tiered))
+
+	quote, err := svc.QuotePayoff(context.Background(), p.ID(), base.Add(48*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, "tier:C", quote.Days[0].RateSource)
+	require.Equal(t, "tier:C/after:1d", quote.Days[1].RateSource)
+
+	updated, err := svc.AccruePayment(context.Background(), p.ID())
+	require.NoError(t, err)
+	// day 1: 5% of 10000 = 500; day 2: 10% of 10500 = 1050
+	require.Equal(t, "1550", updated.OverdueInfo().Penalty.Amount().String())
+}
+
+func TestCreatePayment_OnlyForActiveUsers(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	users := uuser.NewInMemoryUserRepo()
+	newUser := func(transitions ...func(*user.User) error) user.ID {
+		u, err := user.New("tester", base)
+		require.NoError(t, err)
+		for _, transition := range transitions {
+			require.NoError(t, transition(u))
+		}
+		require.NoError(t, users.Save(ctx, u))
+		return u.ID()
+	}
+	verify := func(u *user.User) error { return u.VerifyKYC(base) }
+	active := newUser(verify)
+	pending := newUser()
+	suspended := newUser(verify, func(u *user.User) error { return u.Suspend("fraud review", base) })
+	closed := newUser(verify, func(u *user.User) error { return u.Close("deceased", base) })
+
+	repo := NewInMemoryPaymentRepo()
+	svc := NewService(repo, dp.FixedClock{NowTime: base}, dp.StaticDailyRate{}, WithUserRepository(users))
+
+	p, err := svc.CreatePayment(ctx, active, mustKRW(t, 10_000), base.AddDate(0, 0, 30))
+	require.NoError(t, err)
+	stored, err := repo.Get(ctx, p.ID())
+	require.NoError(t, err)
+	require.Equal(t, dp.StatusScheduled, stored.Status())
+
+	for _, id := range []user.ID{pending, suspended, closed} {
+		_, err := svc.CreatePayment(ctx, id, mustKRW(t, 10_000), base.AddDate(0, 0, 30))
+		require.ErrorIs(t, err, user.ErrUserNotActive)
+	}
+	require.Equal(t, 1, repo.SaveCount())
+
+	_, err = NewService(repo, dp.FixedClock{NowTime: base}, dp.StaticDailyRate{}).
+		CreatePayment(ctx, active, mustKRW(t, 10_000), base)
+	require.ErrorIs(t, err, ErrUserRepositoryRequired)
+}
+
 func mustUserID(t *testing.T, now time.Time) user.ID {
 	u, err := user.New("tester", now)
 	require.NoError(t, err)
@@ -0,0 +1,32 @@
+package payment
+
+import (
+	"context"
+
+	dp "github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/usecase/event"
+)
+
+// UnitOfWork runs fn with a payment repository and an event publisher that
+// share one transaction, so a saved payment and the events it raised are
+// committed together or not at all.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(payments dp.Repository, events event.Publisher) error) error
+}
+
+// InMemoryUnitOfWork hands fn the given repository and publisher directly.
+// It cannot roll back, which in-memory wiring does not need.
+type InMemoryUnitOfWork struct {
+	repo   dp.Repository
+	events event.Publisher
+}
+
+func NewInMemoryUnitOfWork(repo dp.Repository, events event.Publisher) *InMemoryUnitOfWork {
+	return &InMemoryUnitOfWork{repo: repo, events: events}
+}
+
+func (u *InMemoryUnitOfWork) Do(ctx context.Context, fn func(payments dp.Repository, events event.Publisher) error) error {
+	return fn(u.repo, u.events)
+}
+
+var _ UnitOfWork = (*InMemoryUnitOfWork)(nil)
@@ -0,0 +1,113 @@
+package plan
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	dplan "github.com/jaeyoung0509/compound-interest/domain/plan"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+)
+
+// InMemoryPlanRepo is a simple fake repository for tests and local usage.
+type InMemoryPlanRepo struct {
+	mu       sync.Mutex
+	store    map[shared.ID]*dplan.Plan
+	GetErr   error
+	SaveErr  error
+	saveHits int
+}
+
+func NewInMemoryPlanRepo() *InMemoryPlanRepo {
+	return &InMemoryPlanRepo{
+		store: make(map[shared.ID]*dplan.Plan),
+	}
+}
+
+func (r *InMemoryPlanRepo) Get(ctx context.Context, id shared.ID) (*dplan.Plan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.GetErr != nil {
+		return nil, r.GetErr
+	}
+	p, ok := r.store[id]
+	if !ok {
+		return nil, dplan.ErrPlanNotFound
+	}
+	return clonePlan(p)
+}
+
+func (r *InMemoryPlanRepo) FindByPaymentID(ctx context.Context, paymentID shared.ID) (*dplan.Plan, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.GetErr != nil {
+		return nil, r.GetErr
+	}
+	for _, p := range r.store {
+		for _, inst := range p.Installments() {
+			if inst.PaymentID == paymentID {
+				return clonePlan(p)
+			}
+		}
+	}
+	return nil, dplan.ErrPlanNotFound
+}
+
+func (r *InMemoryPlanRepo) Save(ctx context.Context, p *dplan.Plan) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.SaveErr != nil {
+		return r.SaveErr
+	}
+	if stored, ok := r.store[p.ID()]; ok && stored.Version() != p.Version() {
+		return dplan.ErrConcurrentModification
+	}
+	stored, err := clonePlan(p)
+	if err != nil {
+		return err
+	}
+	p.IncrementVersion()
+	stored.IncrementVersion()
+	r.store[p.ID()] = stored
+	r.saveHits++
+	return nil
+}
+
+// Checkpoint captures the stored plans; calling restore rolls the repository
+// back to them, as InMemoryUnitOfWork does on failure.
+func (r *InMemoryPlanRepo) Checkpoint() (restore func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	store := maps.Clone(r.store)
+	saveHits := r.saveHits
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.store, r.saveHits = store, saveHits
+	}
+}
+
+func (r *InMemoryPlanRepo) SaveCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.saveHits
+}
+
+// clonePlan detaches the installment slice so callers cannot mutate stored state.
+func clonePlan(p *dplan.Plan) (*dplan.Plan, error) {
+	return dplan.Reconstitute(dplan.Snapshot{
+		ID:               p.ID(),
+		UserID:           p.UserID(),
+		Total:            p.Total(),
+		Frequency:        p.Frequency(),
+		Remainder:        p.Remainder(),
+		DefaultAfterDays: p.DefaultAfterDays(),
+		Installments:     p.Installments(),
+		Status:           p.Status(),
+		CreatedAt:        p.CreatedAt(),
+		UpdatedAt:        p.UpdatedAt(),
+		Version:          p.Version(),
+	})
+}
+
+var _ dplan.Repository = (*InMemoryPlanRepo)(nil)
@@ -0,0 +1,139 @@
+package plan
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	dplan "github.com/jaeyoung0509/compound-interest/domain/plan"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+)
+
+const defaultMaxRetries = 3
+
+// Service starts installment plans and keeps their status in sync with child payments.
+type Service struct {
+	plans      dplan.Repository
+	payments   payment.Repository
+	users      user.Repository
+	uow        UnitOfWork
+	clock      payment.Clock
+	ids        shared.IDGenerator
+	maxRetries int
+}
+
+// Option customizes a Service.
+type Option func(*Service)
+
+// WithMaxRetries bounds how often a plan update is reloaded and reapplied
+// after ErrConcurrentModification. Zero disables retries.
+func WithMaxRetries(n int) Option {
+	return func(s *Service) {
+		if n >= 0 {
+			s.maxRetries = n
+		}
+	}
+}
+
+// WithUnitOfWork stores a new plan and its installment payments through uow,
+// e.g. a Postgres transaction. The default wraps the service's repositories
+// and rolls back only those that support it.
+func WithUnitOfWork(uow UnitOfWork) Option {
+	return func(s *Service) {
+		if uow != nil {
+			s.uow = uow
+		}
+	}
+}
+
+// WithIDGenerator issues plan and installment payment IDs from gen.
+func WithIDGenerator(gen shared.IDGenerator) Option {
+	return func(s *Service) {
+		if gen != nil {
+			s.ids = gen
+		}
+	}
+}
+
+func NewService(plans dplan.Repository, payments payment.Repository, users user.Repository, clock payment.Clock, opts ...Option) *Service {
+	s := &Service{
+		plans:      plans,
+		payments:   payments,
+		users:      users,
+		uow:        NewInMemoryUnitOfWork(plans, payments),
+		clock:      clock,
+		ids:        shared.DefaultIDGenerator(),
+		maxRetries: defaultMaxRetries,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// StartPlan splits a purchase into scheduled payments and persists them with
+// the plan in one unit of work, so a failed save leaves neither behind. Only
+// ACTIVE users may start a plan (user.ErrUserNotActive).
+func (s *Service) StartPlan(ctx context.Context, userID user.ID, terms dplan.Terms) (*dplan.Plan, error) {
+	u, err := s.users.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !u.Status().CanBorrow() {
+		return nil, user.ErrUserNotActive
+	}
+
+	p, payments, err := dplan.New(userID, terms, s.clock.Now(), dplan.WithIDGenerator(s.ids))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.uow.Do(ctx, func(plans dplan.Repository, paymentRepo payment.Repository) error {
+		for _, pay := range payments {
+			if err := paymentRepo.Save(ctx, pay); err != nil {
+				return err
+			}
+		}
+		return plans.Save(ctx, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// HandlePaymentEvent applies a payment event to the plan owning that payment,
+// reloading and reapplying when another installment's event saved the plan in
+// between. Events for payments outside any plan are ignored.
+func (s *Service) HandlePaymentEvent(ctx context.Context, evt shared.DomainEvent) error {
+	switch evt.(type) {
+	case payment.PaymentPaid, payment.OverdueAccrued:
+	default:
+		return nil
+	}
+
+	paymentID, err := shared.ParseID(evt.AggregateID())
+	if err != nil {
+		return err
+	}
+	for attempt := 0; attempt <= s.maxRetries; attempt++ {
+		var p *dplan.Plan
+		p, err = s.plans.FindByPaymentID(ctx, paymentID)
+		if errors.Is(err, dplan.ErrPlanNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := p.Apply(evt); err != nil {
+			return err
+		}
+		err = s.plans.Save(ctx, p)
+		if !errors.Is(err, dplan.ErrConcurrentModification) {
+			return err
+		}
+	}
+	return err
+}
@@ -0,0 +1,261 @@
+package plan
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	dp "github.com/jaeyoung0509/compound-interest/domain/payment"
+	dplan "github.com/jaeyoung0509/compound-interest/domain/plan"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/usecase/event"
+	upayment "github.com/jaeyoung0509/compound-interest/usecase/payment"
+	uuser "github.com/jaeyoung0509/compound-interest/usecase/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartPlan_PersistsPlanAndPayments(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := uuser.NewInMemoryUserRepo()
+	uid := mustActiveUser(t, users, base)
+
+	plans := NewInMemoryPlanRepo()
+	payments := upayment.NewInMemoryPaymentRepo()
+	svc := NewService(plans, payments, users, dp.FixedClock{NowTime: base})
+
+	p, err := svc.StartPlan(context.Background(), uid, dplan.Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 4,
+		Frequency:    dplan.FrequencyBiweekly,
+		FirstDueDate: base,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, plans.SaveCount())
+	require.Equal(t, 4, payments.SaveCount())
+
+	for _, inst := range p.Installments() {
+		stored, err := payments.Get(context.Background(), inst.PaymentID)
+		require.NoError(t, err)
+		require.True(t, stored.Amount().Amount().Equal(inst.Amount.Amount()))
+	}
+}
+
+func TestHandlePaymentEvent_CompletesPlan(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := uuser.NewInMemoryUserRepo()
+	uid := mustActiveUser(t, users, base)
+	ctx := context.Background()
+
+	plans := NewInMemoryPlanRepo()
+	payments := upayment.NewInMemoryPaymentRepo()
+	svc := NewService(plans, payments, users, dp.FixedClock{NowTime: base})
+
+	p, err := svc.StartPlan(ctx, uid, dplan.Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 2,
+		Frequency:    dplan.FrequencyWeekly,
+		FirstDueDate: base,
+	})
+	require.NoError(t, err)
+
+	for _, inst := range p.Installments() {
+		pay, err := payments.Get(ctx, inst.PaymentID)
+		require.NoError(t, err)
+		require.NoError(t, pay.Pay(inst.DueDate))
+		for _, evt := range pay.PullEvents() {
+			require.NoError(t, svc.HandlePaymentEvent(ctx, evt))
+		}
+	}
+
+	stored, err := plans.Get(ctx, p.ID())
+	require.NoError(t, err)
+	require.Equal(t, dplan.StatusCompleted, stored.Status())
+}
+
+func TestHandlePaymentEvent_FollowsPaymentServiceEvents(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := uuser.NewInMemoryUserRepo()
+	uid := mustActiveUser(t, users, base)
+	ctx := context.Background()
+
+	plans := NewInMemoryPlanRepo()
+	payments := upayment.NewInMemoryPaymentRepo()
+	svc := NewService(plans, payments, users, dp.FixedClock{NowTime: base})
+	events := event.NewDispatcher(svc.HandlePaymentEvent)
+	uow := upayment.NewInMemoryUnitOfWork(payments, events)
+
+	p, err := svc.StartPlan(ctx, uid, dplan.Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 2,
+		Frequency:    dplan.FrequencyWeekly,
+		FirstDueDate: base,
+	})
+	require.NoError(t, err)
+	insts := p.Installments()
+
+	payer := upayment.NewService(payments, dp.FixedClock{NowTime: insts[0].DueDate}, dp.StaticDailyRate{},
+		upayment.WithUnitOfWork(uow))
+	_, err = payer.PayPayment(ctx, insts[0].PaymentID)
+	require.NoError(t, err)
+
+	stored, err := plans.Get(ctx, p.ID())
+	require.NoError(t, err)
+	require.Equal(t, dplan.StatusActive, stored.Status())
+	require.True(t, stored.Installments()[0].Paid)
+
+	collector := upayment.NewService(payments, dp.FixedClock{NowTime: insts[1].DueDate.AddDate(0, 0, 90)},
+		dp.StaticDailyRate{BPS: 1}, upayment.WithUnitOfWork(uow))
+	_, err = collector.AccruePayment(ctx, insts[1].PaymentID)
+	require.NoError(t, err)
+
+	stored, err = plans.Get(ctx, p.ID())
+	require.NoError(t, err)
+	require.Equal(t, dplan.StatusDefaulted, stored.Status())
+}
+
+func TestHandlePaymentEvent_RetriesAfterConcurrentSave(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := uuser.NewInMemoryUserRepo()
+	uid := mustActiveUser(t, users, base)
+	ctx := context.Background()
+
+	plans := &racingPlanRepo{InMemoryPlanRepo: NewInMemoryPlanRepo()}
+	payments := upayment.NewInMemoryPaymentRepo()
+	svc := NewService(plans, payments, users, dp.FixedClock{NowTime: base})
+
+	p, err := svc.StartPlan(ctx, uid, dplan.Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 2,
+		Frequency:    dplan.FrequencyWeekly,
+		FirstDueDate: base,
+	})
+	require.NoError(t, err)
+
+	var events []shared.DomainEvent
+	for _, inst := range p.Installments() {
+		pay, err := payments.Get(ctx, inst.PaymentID)
+		require.NoError(t, err)
+		require.NoError(t, pay.Pay(inst.DueDate))
+		events = append(events, pay.PullEvents()...)
+	}
+
+	// The second installment's event is handled while the first is in flight.
+	plans.races = 1
+	plans.race = func(ctx context.Context) error {
+		return svc.HandlePaymentEvent(ctx, events[1])
+	}
+	require.NoError(t, svc.HandlePaymentEvent(ctx, events[0]))
+
+	stored, err := plans.Get(ctx, p.ID())
+	require.NoError(t, err)
+	require.Equal(t, dplan.StatusCompleted, stored.Status())
+	require.Equal(t, int64(3), stored.Version())
+}
+
+func TestHandlePaymentEvent_IgnoresStandalonePayments(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := uuser.NewInMemoryUserRepo()
+	uid := mustActiveUser(t, users, base)
+
+	pay, err := dp.New(uid, mustKRW(t, 1_000), base, base)
+	require.NoError(t, err)
+	require.NoError(t, pay.Pay(base))
+
+	plans := NewInMemoryPlanRepo()
+	svc := NewService(plans, upayment.NewInMemoryPaymentRepo(), users, dp.FixedClock{NowTime: base})
+	for _, evt := range pay.PullEvents() {
+		require.NoError(t, svc.HandlePaymentEvent(context.Background(), evt))
+	}
+	require.Equal(t, 0, plans.SaveCount())
+}
+
+func TestStartPlan_RejectsInactiveUser(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := uuser.NewInMemoryUserRepo()
+	pending, err := user.New("tester", base)
+	require.NoError(t, err)
+	require.NoError(t, users.Save(context.Background(), pending))
+
+	plans := NewInMemoryPlanRepo()
+	payments := upayment.NewInMemoryPaymentRepo()
+	svc := NewService(plans, payments, users, dp.FixedClock{NowTime: base})
+	terms := dplan.Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 2,
+		Frequency:    dplan.FrequencyWeekly,
+		FirstDueDate: base,
+	}
+
+	_, err = svc.StartPlan(context.Background(), pending.ID(), terms)
+	require.ErrorIs(t, err, user.ErrUserNotActive)
+
+	suspended := mustActiveUser(t, users, base)
+	u, err := users.Get(context.Background(), suspended)
+	require.NoError(t, err)
+	require.NoError(t, u.Suspend("fraud review", base))
+	require.NoError(t, users.Save(context.Background(), u))
+
+	_, err = svc.StartPlan(context.Background(), suspended, terms)
+	require.ErrorIs(t, err, user.ErrUserNotActive)
+	require.Equal(t, 0, plans.SaveCount())
+	require.Equal(t, 0, payments.SaveCount())
+}
+
+// racingPlanRepo lets another handler save between the service's load and Save.
+func TestStartPlan_RollsBackPaymentsWhenPlanSaveFails(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := uuser.NewInMemoryUserRepo()
+	uid := mustActiveUser(t, users, base)
+	ctx := context.Background()
+
+	plans := NewInMemoryPlanRepo()
+	plans.SaveErr = errors.New("plans unavailable")
+	payments := upayment.NewInMemoryPaymentRepo()
+	svc := NewService(plans, payments, users, dp.FixedClock{NowTime: base})
+
+	_, err := svc.StartPlan(ctx, uid, dplan.Terms{
+		Total:        mustKRW(t, 10_000),
+		Installments: 3,
+		Frequency:    dplan.FrequencyWeekly,
+		FirstDueDate: base,
+	})
+	require.ErrorIs(t, err, plans.SaveErr)
+
+	stored, err := payments.ListByUser(ctx, uid)
+	require.NoError(t, err)
+	require.Empty(t, stored)
+	require.Zero(t, payments.SaveCount())
+}
+
+type racingPlanRepo struct {
+	*InMemoryPlanRepo
+	races int
+	race  func(ctx context.Context) error
+}
+
+func (r *racingPlanRepo) Save(ctx context.Context, p *dplan.Plan) error {
+	if r.races > 0 {
+		r.races--
+		if err := r.race(ctx); err != nil {
+			return err
+		}
+	}
+	return r.InMemoryPlanRepo.Save(ctx, p)
+}
+
+func mustActiveUser(t *testing.T, users *uuser.InMemoryUserRepo, now time.Time) user.ID {
+	u, err := user.New("tester", now)
+	require.NoError(t, err)
+	require.NoError(t, u.VerifyKYC(now))
+	require.NoError(t, users.Save(context.Background(), u))
+	return u.ID()
+}
+
+func mustKRW(t *testing.T, minor int64) money.Money {
+	m, err := money.FromMinor(minor, money.CurrencyKRW)
+	require.NoError(t, err)
+	return m
+}
@@ -0,0 +1,56 @@
+package plan
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	dplan "github.com/jaeyoung0509/compound-interest/domain/plan"
+)
+
+// UnitOfWork runs fn with plan and payment repositories that share one
+// transaction, so a plan and its installment payments are stored together or
+// not at all.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(plans dplan.Repository, payments payment.Repository) error) error
+}
+
+// checkpointer is implemented by in-memory repositories that can roll back,
+// such as InMemoryPlanRepo and the payment package's InMemoryPaymentRepo.
+type checkpointer interface {
+	Checkpoint() (restore func())
+}
+
+// InMemoryUnitOfWork hands fn the given repositories and, when fn fails,
+// restores those implementing Checkpoint. Units run one at a time; writes
+// made outside a unit while one is running are lost on rollback.
+type InMemoryUnitOfWork struct {
+	mu       sync.Mutex
+	plans    dplan.Repository
+	payments payment.Repository
+}
+
+func NewInMemoryUnitOfWork(plans dplan.Repository, payments payment.Repository) *InMemoryUnitOfWork {
+	return &InMemoryUnitOfWork{plans: plans, payments: payments}
+}
+
+func (u *InMemoryUnitOfWork) Do(ctx context.Context, fn func(plans dplan.Repository, payments payment.Repository) error) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	var restores []func()
+	for _, repo := range []any{u.plans, u.payments} {
+		if c, ok := repo.(checkpointer); ok {
+			restores = append(restores, c.Checkpoint())
+		}
+	}
+	if err := fn(u.plans, u.payments); err != nil {
+		for _, restore := range restores {
+			restore()
+		}
+		return err
+	}
+	return nil
+}
+
+var _ UnitOfWork = (*InMemoryUnitOfWork)(nil)
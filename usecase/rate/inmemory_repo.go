@@ -0,0 +1,62 @@
+package rate
+
+import (
+	"context"
+	"sync"
+
+	drate "github.com/jaeyoung0509/compound-interest/domain/rate"
+)
+
+// InMemoryRateRepo is a simple fake repository for tests and local usage.
+type InMemoryRateRepo struct {
+	mu       sync.Mutex
+	entries  map[drate.Key][]drate.Entry
+	TableErr error
+	loadHits int
+}
+
+func NewInMemoryRateRepo() *InMemoryRateRepo {
+	return &InMemoryRateRepo{
+		entries: make(map[drate.Key][]drate.Entry),
+	}
+}
+
+func (r *InMemoryRateRepo) Table(ctx context.Context, key drate.Key) (drate.Table, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.loadHits++
+	if r.TableErr != nil {
+		return drate.Table{}, r.TableErr
+	}
+	return drate.NewTable(key, r.entries[key]...)
+}
+
+// Apply mirrors the Postgres exclusion constraint by rebuilding the table.
+func (r *InMemoryRateRepo) Apply(ctx context.Context, change drate.Change) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := change.Added.Key
+	entries := make([]drate.Entry, 0, len(r.entries[key])+1)
+	for _, e := range r.entries[key] {
+		if change.Closed != nil && e.ID == change.Closed.ID {
+			e = *change.Closed
+		}
+		entries = append(entries, e)
+	}
+	entries = append(entries, change.Added)
+	table, err := drate.NewTable(key, entries...)
+	if err != nil {
+		return err
+	}
+	r.entries[key] = table.Entries()
+	return nil
+}
+
+// LoadCount reports how often Table was called, e.g. to observe caching.
+func (r *InMemoryRateRepo) LoadCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.loadHits
+}
+
+var _ drate.Repository = (*InMemoryRateRepo)(nil)
@@ -0,0 +1,156 @@
+package rate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	drate "github.com/jaeyoung0509/compound-interest/domain/rate"
+)
+
+const defaultCacheTTL = 5 * time.Minute
+
+// Provider serves rate tables from a repository through a per-key cache.
+// Entries expire after the TTL so changes scheduled by other processes are
+// picked up; Invalidate drops a key immediately after a local change.
+type Provider struct {
+	repo  drate.Repository
+	clock payment.Clock
+	ttl   time.Duration
+
+	mu    sync.Mutex
+	cache map[drate.Key]cachedTable
+}
+
+type cachedTable struct {
+	table    drate.Table
+	loadedAt time.Time
+}
+
+// ProviderOption customizes a Provider.
+type ProviderOption func(*Provider)
+
+// WithCacheTTL sets how long a loaded table is served before reloading. Zero
+// disables caching.
+func WithCacheTTL(ttl time.Duration) ProviderOption {
+	return func(p *Provider) {
+		if ttl >= 0 {
+			p.ttl = ttl
+		}
+	}
+}
+
+func NewProvider(repo drate.Repository, clock payment.Clock, opts ...ProviderOption) *Provider {
+	p := &Provider{
+		repo:  repo,
+		clock: clock,
+		ttl:   defaultCacheTTL,
+		cache: make(map[drate.Key]cachedTable),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Table returns the cached table for key, loading it when missing or expired.
+func (p *Provider) Table(ctx context.Context, key drate.Key) (drate.Table, error) {
+	now := p.clock.Now()
+	p.mu.Lock()
+	cached, ok := p.cache[key]
+	p.mu.Unlock()
+	if ok && now.Sub(cached.loadedAt) < p.ttl {
+		return cached.table, nil
+	}
+
+	table, err := p.repo.Table(ctx, key)
+	if err != nil {
+		return drate.Table{}, err
+	}
+	p.mu.Lock()
+	p.cache[key] = cachedTable{table: table, loadedAt: now}
+	p.mu.Unlock()
+	return table, nil
+}
+
+// Invalidate drops the cached table for key.
+func (p *Provider) Invalidate(key drate.Key) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.cache, key)
+}
+
+// ResolveDailyRate prices the accrual day from the table of the payment's
+// product and currency, using the entry in effect on q.Date. The source reads
+// "rate_table:<entry id>".
+func (p *Provider) ResolveDailyRate(q payment.RateQuery) (payment.AppliedRate, error) {
+	table, err := p.Table(context.Background(), drate.Key{Product: q.Product, Currency: q.Currency})
+	if err != nil {
+		return payment.AppliedRate{}, err
+	}
+	entry, err := table.At(q.Date)
+	if err != nil {
+		return payment.AppliedRate{}, err
+	}
+	return payment.AppliedRate{Rate: entry.DailyRate, Source: "rate_table:" + entry.ID.String()}, nil
+}
+
+// For binds the provider to one product and currency so it can drive
+// payment accrual, preferably as a payment.RateResolver so every accrued day
+// is priced by the entry in effect on that day.
+func (p *Provider) For(key drate.Key) *ProductRates {
+	return &ProductRates{provider: p, key: key}
+}
+
+// ProductRates answers daily rate lookups for a single rate table key.
+type ProductRates struct {
+	provider *Provider
+	key      drate.Key
+}
+
+// DailyRate returns the rate in effect at the given time. The payment ports
+// carry no context, so lookups run under context.Background.
+func (r *ProductRates) DailyRate(at time.Time) (money.Rate, error) {
+	table, err := r.provider.Table(context.Background(), r.key)
+	if err != nil {
+		return money.Rate{}, err
+	}
+	entry, err := table.At(at)
+	if err != nil {
+		return money.Rate{}, err
+	}
+	return entry.DailyRate, nil
+}
+
+// ResolveDailyRate prices the accrual day from the entry covering q.Date, so
+// a window spanning a scheduled change uses the old rate up to the change and
+// the new one after it. Queries for another product or currency fail with
+// drate.ErrRateNotFound rather than borrowing this key's table; use
+// Provider.ResolveDailyRate to serve every product. The source reads
+// "rate_table:<entry id>".
+func (r *ProductRates) ResolveDailyRate(q payment.RateQuery) (payment.AppliedRate, error) {
+	if key := (drate.Key{Product: q.Product, Currency: q.Currency}); key != r.key {
+		return payment.AppliedRate{}, fmt.Errorf("%w: rates for %s/%s asked for %s/%s",
+			drate.ErrRateNotFound, r.key.Product, r.key.Currency, key.Product, key.Currency)
+	}
+	return r.provider.ResolveDailyRate(q)
+}
+
+// DailyRateBPS reports the rate rounded to whole basis points.
+func (r *ProductRates) DailyRateBPS(at time.Time) (int64, error) {
+	rate, err := r.DailyRate(at)
+	if err != nil {
+		return 0, err
+	}
+	return rate.BPS().Round(0).IntPart(), nil
+}
+
+var (
+	_ payment.RateResolver      = (*Provider)(nil)
+	_ payment.DailyRateProvider = (*ProductRates)(nil)
+	_ payment.DailyRater        = (*ProductRates)(nil)
+	_ payment.RateResolver      = (*ProductRates)(nil)
+)
@@ -0,0 +1,76 @@
+package rate
+
+import (
+	"context"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	drate "github.com/jaeyoung0509/compound-interest/domain/rate"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+)
+
+// Service lets pricing admins inspect and schedule rate changes.
+type Service struct {
+	repo     drate.Repository
+	provider *Provider
+	clock    payment.Clock
+	ids      shared.IDGenerator
+}
+
+// Option customizes a Service.
+type Option func(*Service)
+
+// WithIDGenerator issues entry IDs from gen.
+func WithIDGenerator(gen shared.IDGenerator) Option {
+	return func(s *Service) {
+		if gen != nil {
+			s.ids = gen
+		}
+	}
+}
+
+// NewService wires the service; provider may be nil when no cache needs
+// invalidating in this process.
+func NewService(repo drate.Repository, provider *Provider, clock payment.Clock, opts ...Option) *Service {
+	s := &Service{
+		repo:     repo,
+		provider: provider,
+		clock:    clock,
+		ids:      shared.DefaultIDGenerator(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Table returns the stored table for key, bypassing the cache.
+func (s *Service) Table(ctx context.Context, key drate.Key) (drate.Table, error) {
+	return s.repo.Table(ctx, key)
+}
+
+// ScheduleRateChange makes dailyRate effective for key from the given time,
+// which must lie in the future so accruals already posted are never repriced.
+// The entry in effect at that time is closed there.
+func (s *Service) ScheduleRateChange(ctx context.Context, key drate.Key, from time.Time, dailyRate money.Rate) (drate.Entry, error) {
+	now := s.clock.Now()
+	if !from.After(now) {
+		return drate.Entry{}, drate.ErrNotInFuture
+	}
+	table, err := s.repo.Table(ctx, key)
+	if err != nil {
+		return drate.Entry{}, err
+	}
+	_, change, err := table.Schedule(s.ids.NewID(), from, dailyRate, now)
+	if err != nil {
+		return drate.Entry{}, err
+	}
+	if err := s.repo.Apply(ctx, change); err != nil {
+		return drate.Entry{}, err
+	}
+	if s.provider != nil {
+		s.provider.Invalidate(key)
+	}
+	return change.Added, nil
+}
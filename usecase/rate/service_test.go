@@ -0,0 +1,142 @@
+package rate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	dp "github.com/jaeyoung0509/compound-interest/domain/payment"
+	drate "github.com/jaeyoung0509/compound-interest/domain/rate"
+	"github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = drate.Key{Product: "BNPL_30", Currency: money.CurrencyKRW}
+
+func TestScheduleRateChange_InvalidatesCache(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := dp.FixedClock{NowTime: base}
+	repo := NewInMemoryRateRepo()
+	provider := NewProvider(repo, clock)
+	svc := NewService(repo, provider, clock)
+
+	_, err := svc.ScheduleRateChange(context.Background(), testKey, base.AddDate(0, 0, 1), money.RateFromBPS(10))
+	require.NoError(t, err)
+
+	rates := provider.For(testKey)
+	bps, err := rates.DailyRateBPS(base.AddDate(0, 0, 5))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), bps)
+	_, err = rates.DailyRateBPS(base)
+	require.ErrorIs(t, err, drate.ErrRateNotFound)
+	require.Equal(t, 2, repo.LoadCount()) // one load by the service, one cached by the provider
+
+	_, err = svc.ScheduleRateChange(context.Background(), testKey, base.AddDate(0, 0, 3), money.RateFromBPS(12))
+	require.NoError(t, err)
+	bps, err = rates.DailyRateBPS(base.AddDate(0, 0, 5))
+	require.NoError(t, err)
+	require.Equal(t, int64(12), bps)
+	bps, err = rates.DailyRateBPS(base.AddDate(0, 0, 2))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), bps)
+}
+
+func TestScheduleRateChange_RejectsPastAndDuplicateStart(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewInMemoryRateRepo()
+	svc := NewService(repo, nil, dp.FixedClock{NowTime: base})
+
+	_, err := svc.ScheduleRateChange(context.Background(), testKey, base, money.RateFromBPS(10))
+	require.ErrorIs(t, err, drate.ErrNotInFuture)
+
+	from := base.AddDate(0, 1, 0)
+	_, err = svc.ScheduleRateChange(context.Background(), testKey, from, money.RateFromBPS(10))
+	require.NoError(t, err)
+	_, err = svc.ScheduleRateChange(context.Background(), testKey, from, money.RateFromBPS(11))
+	require.ErrorIs(t, err, drate.ErrOverlappingRange)
+}
+
+func TestProvider_ExpiresCachedTables(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewInMemoryRateRepo()
+	_, err := NewService(repo, nil, dp.FixedClock{NowTime: base}).
+		ScheduleRateChange(context.Background(), testKey, base.Add(time.Hour), money.RateFromBPS(10))
+	require.NoError(t, err)
+	loads := repo.LoadCount()
+
+	clock := &dp.FixedClock{NowTime: base}
+	provider := NewProvider(repo, clock, WithCacheTTL(time.Minute))
+	for i := 0; i < 3; i++ {
+		_, err := provider.Table(context.Background(), testKey)
+		require.NoError(t, err)
+	}
+	require.Equal(t, loads+1, repo.LoadCount())
+
+	clock.NowTime = base.Add(time.Minute)
+	_, err = provider.Table(context.Background(), testKey)
+	require.NoError(t, err)
+	require.Equal(t, loads+2, repo.LoadCount())
+}
+
+func TestProductRates_PricesEachDayFromItsEntry(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewInMemoryRateRepo()
+	svc := NewService(repo, nil, dp.FixedClock{NowTime: base.Add(-time.Hour)})
+	first, err := svc.ScheduleRateChange(context.Background(), testKey, base, money.RateFromBPS(100))
+	require.NoError(t, err)
+	second, err := svc.ScheduleRateChange(context.Background(), testKey, base.AddDate(0, 0, 3), money.RateFromBPS(200))
+	require.NoError(t, err)
+
+	u, err := user.New("tester", base)
+	require.NoError(t, err)
+	amount, err := money.FromMinor(10_000, money.CurrencyKRW)
+	require.NoError(t, err)
+	p, err := dp.New(u.ID(), amount, base, base,
+		dp.WithProduct(dp.Product{Code: testKey.Product, Rounding: money.RoundHalfUp}))
+	require.NoError(t, err)
+
+	rates := NewProvider(repo, dp.FixedClock{NowTime: base}).For(testKey)
+	quote, err := p.QuoteAtResolved(base.AddDate(0, 0, 4), rates)
+	require.NoError(t, err)
+	require.Len(t, quote.Days, 4)
+	for i, day := range quote.Days {
+		want := first
+		if i >= 2 { // days 3 and 4 fall on or after the change
+			want = second
+		}
+		require.True(t, want.DailyRate.Equal(day.Rate), "day %d", i+1)
+		require.Equal(t, "rate_table:"+want.ID.String(), day.RateSource)
+	}
+	// 1% on 10000 and 10100, then 2% on 10201 and 10405.02
+	require.Equal(t, "613", quote.Penalty.Amount().String())
+}
+
+func TestProvider_ResolvesEachProductFromItsOwnTable(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	otherKey := drate.Key{Product: "BNPL_60", Currency: money.CurrencyKRW}
+	repo := NewInMemoryRateRepo()
+	svc := NewService(repo, nil, dp.FixedClock{NowTime: base.Add(-time.Hour)})
+	_, err := svc.ScheduleRateChange(context.Background(), testKey, base, money.RateFromBPS(100))
+	require.NoError(t, err)
+	_, err = svc.ScheduleRateChange(context.Background(), otherKey, base, money.RateFromBPS(300))
+	require.NoError(t, err)
+
+	provider := NewProvider(repo, dp.FixedClock{NowTime: base})
+	day := base.AddDate(0, 0, 1)
+	for key, bps := range map[drate.Key]int64{testKey: 100, otherKey: 300} {
+		q := dp.RateQuery{Product: key.Product, Currency: key.Currency, Date: day, DaysOverdue: 1}
+		applied, err := provider.ResolveDailyRate(q)
+		require.NoError(t, err)
+		require.True(t, applied.Rate.Equal(money.RateFromBPS(bps)), key.Product)
+
+		applied, err = provider.For(key).ResolveDailyRate(q)
+		require.NoError(t, err)
+		require.True(t, applied.Rate.Equal(money.RateFromBPS(bps)), key.Product)
+	}
+
+	_, err = provider.For(testKey).ResolveDailyRate(dp.RateQuery{
+		Product: otherKey.Product, Currency: otherKey.Currency, Date: day, DaysOverdue: 1,
+	})
+	require.ErrorIs(t, err, drate.ErrRateNotFound)
+}
@@ -0,0 +1,102 @@
+package user
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	duser "github.com/jaeyoung0509/compound-interest/domain/user"
+)
+
+// InMemoryUserRepo is a simple fake repository for tests and local usage.
+type InMemoryUserRepo struct {
+	mu       sync.Mutex
+	store    map[duser.ID]*duser.User
+	GetErr   error
+	SaveErr  error
+	saveHits int
+}
+
+func NewInMemoryUserRepo() *InMemoryUserRepo {
+	return &InMemoryUserRepo{
+		store: make(map[duser.ID]*duser.User),
+	}
+}
+
+func (r *InMemoryUserRepo) Get(ctx context.Context, id duser.ID) (*duser.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.GetErr != nil {
+		return nil, r.GetErr
+	}
+	u, ok := r.store[id]
+	if !ok {
+		return nil, duser.ErrUserNotFound
+	}
+	cloned := *u
+	return &cloned, nil
+}
+
+func (r *InMemoryUserRepo) FindByExternalRef(ctx context.Context, ref string) (*duser.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.GetErr != nil {
+		return nil, r.GetErr
+	}
+	if ref == "" {
+		return nil, duser.ErrUserNotFound
+	}
+	for _, u := range r.store {
+		if u.ExternalRef() == ref {
+			cloned := *u
+			return &cloned, nil
+		}
+	}
+	return nil, duser.ErrUserNotFound
+}
+
+func (r *InMemoryUserRepo) Save(ctx context.Context, u *duser.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.SaveErr != nil {
+		return r.SaveErr
+	}
+	if stored, ok := r.store[u.ID()]; ok && stored.Version() != u.Version() {
+		return duser.ErrConcurrentModification
+	}
+	if ref := u.ExternalRef(); ref != "" {
+		for id, other := range r.store {
+			if id != u.ID() && other.ExternalRef() == ref {
+				return duser.ErrExternalRefTaken
+			}
+		}
+	}
+	u.IncrementVersion()
+	stored := *u
+	stored.PullEvents() // events belong to the caller, not to stored state
+	r.store[u.ID()] = &stored
+	r.saveHits++
+	return nil
+}
+
+// Checkpoint captures the stored users; calling restore rolls the repository
+// back to them, as InMemoryUnitOfWork does on failure.
+func (r *InMemoryUserRepo) Checkpoint() (restore func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	store := maps.Clone(r.store)
+	saveHits := r.saveHits
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.store, r.saveHits = store, saveHits
+	}
+}
+
+func (r *InMemoryUserRepo) SaveCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.saveHits
+}
+
+var _ duser.Repository = (*InMemoryUserRepo)(nil)
@@ -0,0 +1,188 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	duser "github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/usecase/event"
+)
+
+// Service registers and looks up users.
+type Service struct {
+	repo  duser.Repository
+	clock payment.Clock
+	uow   UnitOfWork
+}
+
+// Option customizes a Service.
+type Option func(*Service)
+
+// WithUnitOfWork saves users through uow and publishes the events they raised
+// (StatusChanged, UserAnonymized) in the same transaction. Without it events
+// are dropped.
+func WithUnitOfWork(uow UnitOfWork) Option {
+	return func(s *Service) {
+		s.uow = uow
+	}
+}
+
+func NewService(repo duser.Repository, payments payment.UserPayments, clock payment.Clock, opts ...Option) *Service {
+	s := &Service{
+		repo:  repo,
+		clock: clock,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.uow == nil {
+		s.uow = NewInMemoryUnitOfWork(repo, payments, event.NoopPublisher{})
+	}
+	return s
+}
+
+// Register creates and persists a user. A non-empty externalRef must not be
+// linked to another user yet.
+func (s *Service) Register(ctx context.Context, name, externalRef string) (*duser.User, error) {
+	if externalRef != "" {
+		_, err := s.repo.FindByExternalRef(ctx, externalRef)
+		if err == nil {
+			return nil, duser.ErrExternalRefTaken
+		}
+		if !errors.Is(err, duser.ErrUserNotFound) {
+			return nil, err
+		}
+	}
+
+	u, err := duser.New(name, s.clock.Now(), duser.WithExternalRef(externalRef))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (s *Service) Get(ctx context.Context, id duser.ID) (*duser.User, error) {
+	return s.repo.Get(ctx, id)
+}
+
+func (s *Service) FindByExternalRef(ctx context.Context, ref string) (*duser.User, error) {
+	return s.repo.FindByExternalRef(ctx, ref)
+}
+
+// Rename updates the display name and bumps updated_at.
+func (s *Service) Rename(ctx context.Context, id duser.ID, name string) (*duser.User, error) {
+	return s.update(ctx, id, func(u *duser.User) error {
+		return u.Rename(name, s.clock.Now())
+	})
+}
+
+// UpdateContact validates and replaces email and phone.
+func (s *Service) UpdateContact(ctx context.Context, id duser.ID, email, phone string) (*duser.User, error) {
+	contact, err := duser.NewContact(email, phone)
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, id, func(u *duser.User) error {
+		return u.UpdateContact(contact, s.clock.Now())
+	})
+}
+
+// UpdatePreferences replaces the preferred language and notification opt-ins.
+func (s *Service) UpdatePreferences(ctx context.Context, id duser.ID, language string, optIns ...duser.Channel) (*duser.User, error) {
+	prefs, err := duser.NewPreferences(language, optIns...)
+	if err != nil {
+		return nil, err
+	}
+	return s.update(ctx, id, func(u *duser.User) error {
+		return u.UpdatePreferences(prefs, s.clock.Now())
+	})
+}
+
+// VerifyKYC activates a pending user.
+func (s *Service) VerifyKYC(ctx context.Context, id duser.ID) (*duser.User, error) {
+	return s.update(ctx, id, func(u *duser.User) error {
+		return u.VerifyKYC(s.clock.Now())
+	})
+}
+
+func (s *Service) Suspend(ctx context.Context, id duser.ID, reason string) (*duser.User, error) {
+	return s.update(ctx, id, func(u *duser.User) error {
+		return u.Suspend(reason, s.clock.Now())
+	})
+}
+
+func (s *Service) Reinstate(ctx context.Context, id duser.ID, reason string) (*duser.User, error) {
+	return s.update(ctx, id, func(u *duser.User) error {
+		return u.Reinstate(reason, s.clock.Now())
+	})
+}
+
+func (s *Service) Close(ctx context.Context, id duser.ID, reason string) (*duser.User, error) {
+	return s.update(ctx, id, func(u *duser.User) error {
+		return u.Close(reason, s.clock.Now())
+	})
+}
+
+// Anonymize erases the user's personal data on an erasure request. Payments
+// reference users with ON DELETE RESTRICT, so the row and its ID are kept for
+// financial records. It fails with ErrOutstandingBalance while any payment is
+// unpaid; the payments are checked in the unit of work that saves the user,
+// after the user is loaded (and, in Postgres, locked).
+func (s *Service) Anonymize(ctx context.Context, id duser.ID) (*duser.User, error) {
+	var u *duser.User
+	err := s.uow.Do(ctx, func(users duser.Repository, payments payment.UserPayments, events event.Publisher) error {
+		var err error
+		u, err = users.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		owed, err := payments.ListByUser(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, p := range owed {
+			if p.Status() != payment.StatusPaid {
+				return duser.ErrOutstandingBalance
+			}
+		}
+		if err := u.Anonymize(s.clock.Now()); err != nil {
+			return err
+		}
+		if err := users.Save(ctx, u); err != nil {
+			return err
+		}
+		return events.Publish(ctx, u.PullEvents()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// update loads, changes and saves the user in one unit of work, publishing
+// the events the change raised.
+func (s *Service) update(ctx context.Context, id duser.ID, apply func(*duser.User) error) (*duser.User, error) {
+	var u *duser.User
+	err := s.uow.Do(ctx, func(users duser.Repository, _ payment.UserPayments, events event.Publisher) error {
+		var err error
+		u, err = users.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := apply(u); err != nil {
+			return err
+		}
+		if err := users.Save(ctx, u); err != nil {
+			return err
+		}
+		return events.Publish(ctx, u.PullEvents()...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
@@ -0,0 +1,232 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jaeyoung0509/compound-interest/domain/money"
+	dp "github.com/jaeyoung0509/compound-interest/domain/payment"
+	"github.com/jaeyoung0509/compound-interest/domain/shared"
+	duser "github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/usecase/event"
+	upayment "github.com/jaeyoung0509/compound-interest/usecase/payment"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister_PersistsAndFindsByExternalRef(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewInMemoryUserRepo()
+	svc := NewService(repo, upayment.NewInMemoryPaymentRepo(), dp.FixedClock{NowTime: base})
+
+	u, err := svc.Register(context.Background(), " Kim ", "merchant-42")
+	require.NoError(t, err)
+	require.Equal(t, "Kim", u.Name())
+	require.Equal(t, int64(1), u.Version())
+	require.Equal(t, base, u.UpdatedAt())
+
+	found, err := svc.FindByExternalRef(context.Background(), "merchant-42")
+	require.NoError(t, err)
+	require.Equal(t, u.ID(), found.ID())
+
+	_, err = svc.Register(context.Background(), "Lee", "merchant-42")
+	require.ErrorIs(t, err, duser.ErrExternalRefTaken)
+	require.Equal(t, 1, repo.SaveCount())
+
+	_, err = svc.FindByExternalRef(context.Background(), "unknown")
+	require.ErrorIs(t, err, duser.ErrUserNotFound)
+}
+
+func TestRename_UpdatesTimestampAndVersion(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewInMemoryUserRepo()
+
+	u, err := NewService(repo, upayment.NewInMemoryPaymentRepo(), dp.FixedClock{NowTime: base}).Register(context.Background(), "Kim", "")
+	require.NoError(t, err)
+
+	later := base.Add(time.Hour)
+	renamed, err := NewService(repo, upayment.NewInMemoryPaymentRepo(), dp.FixedClock{NowTime: later}).Rename(context.Background(), u.ID(), "Park")
+	require.NoError(t, err)
+	require.Equal(t, "Park", renamed.Name())
+	require.Equal(t, later, renamed.UpdatedAt())
+	require.Equal(t, base, renamed.CreatedAt())
+	require.Equal(t, int64(2), renamed.Version())
+}
+
+func TestSave_RejectsStaleVersion(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewInMemoryUserRepo()
+	svc := NewService(repo, upayment.NewInMemoryPaymentRepo(), dp.FixedClock{NowTime: base})
+
+	u, err := svc.Register(context.Background(), "Kim", "")
+	require.NoError(t, err)
+
+	first, err := repo.Get(context.Background(), u.ID())
+	require.NoError(t, err)
+	second, err := repo.Get(context.Background(), u.ID())
+	require.NoError(t, err)
+
+	require.NoError(t, first.Rename("Park", base))
+	require.NoError(t, repo.Save(context.Background(), first))
+	require.NoError(t, second.Rename("Choi", base))
+	require.ErrorIs(t, repo.Save(context.Background(), second), duser.ErrConcurrentModification)
+}
+
+func TestStatusTransitions_PersistAndPublishEvents(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewInMemoryUserRepo()
+	payments := upayment.NewInMemoryPaymentRepo()
+	var published []shared.DomainEvent
+	events := event.NewDispatcher(func(_ context.Context, evt shared.DomainEvent) error {
+		published = append(published, evt)
+		return nil
+	})
+	svc := NewService(repo, payments, dp.FixedClock{NowTime: base},
+		WithUnitOfWork(NewInMemoryUnitOfWork(repo, payments, events)))
+
+	u, err := svc.Register(context.Background(), "Kim", "")
+	require.NoError(t, err)
+	require.Equal(t, duser.StatusPendingKYC, u.Status())
+
+	_, err = svc.Reinstate(context.Background(), u.ID(), "")
+	require.ErrorIs(t, err, duser.ErrInvalidTransition)
+
+	active, err := svc.VerifyKYC(context.Background(), u.ID())
+	require.NoError(t, err)
+	require.Empty(t, active.PullEvents())
+	require.Len(t, published, 1)
+	require.Equal(t, duser.EventUserStatusChanged, published[0].EventType())
+
+	_, err = svc.Suspend(context.Background(), u.ID(), "chargeback")
+	require.NoError(t, err)
+	stored, err := repo.Get(context.Background(), u.ID())
+	require.NoError(t, err)
+	require.Equal(t, duser.StatusSuspended, stored.Status())
+	require.Len(t, published, 2)
+	require.Equal(t, duser.StatusChanged{
+		UserID:         u.ID().String(),
+		From:           duser.StatusActive,
+		To:             duser.StatusSuspended,
+		Reason:         "chargeback",
+		OccurredAtTime: base,
+	}, published[1])
+}
+
+func TestUpdate_RollsBackWhenPublishFails(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewInMemoryUserRepo()
+	payments := upayment.NewInMemoryPaymentRepo()
+	outboxDown := errors.New("outbox down")
+	events := event.NewDispatcher(func(context.Context, shared.DomainEvent) error {
+		return outboxDown
+	})
+	svc := NewService(repo, payments, dp.FixedClock{NowTime: base},
+		WithUnitOfWork(NewInMemoryUnitOfWork(repo, payments, events)))
+
+	u, err := svc.Register(context.Background(), "Kim", "")
+	require.NoError(t, err)
+	_, err = svc.VerifyKYC(context.Background(), u.ID())
+	require.ErrorIs(t, err, outboxDown)
+
+	stored, err := repo.Get(context.Background(), u.ID())
+	require.NoError(t, err)
+	require.Equal(t, duser.StatusPendingKYC, stored.Status())
+}
+
+func TestUpdateContactAndPreferences(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewInMemoryUserRepo()
+	svc := NewService(repo, upayment.NewInMemoryPaymentRepo(), dp.FixedClock{NowTime: base})
+
+	u, err := svc.Register(context.Background(), "Kim", "")
+	require.NoError(t, err)
+
+	_, err = svc.UpdateContact(context.Background(), u.ID(), "not-an-email", "")
+	require.ErrorIs(t, err, duser.ErrInvalidEmail)
+
+	_, err = svc.UpdateContact(context.Background(), u.ID(), "Kim@Example.com", "")
+	require.NoError(t, err)
+	_, err = svc.UpdatePreferences(context.Background(), u.ID(), "ko-KR", duser.ChannelEmail)
+	require.NoError(t, err)
+
+	stored, err := repo.Get(context.Background(), u.ID())
+	require.NoError(t, err)
+	require.Equal(t, "kim@example.com", stored.Contact().Email)
+	require.True(t, stored.Preferences().Allows(duser.ChannelEmail))
+	require.Equal(t, int64(3), stored.Version())
+}
+
+func TestAnonymize_RefusesOutstandingBalanceThenScrubs(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewInMemoryUserRepo()
+	payments := upayment.NewInMemoryPaymentRepo()
+	svc := NewService(repo, payments, dp.FixedClock{NowTime: base})
+
+	u, err := svc.Register(context.Background(), "Kim", "merchant-42")
+	require.NoError(t, err)
+	_, err = svc.UpdateContact(context.Background(), u.ID(), "kim@example.com", "+821012345678")
+	require.NoError(t, err)
+
+	amount, err := money.FromMinor(10_000, money.CurrencyKRW)
+	require.NoError(t, err)
+	p, err := dp.New(u.ID(), amount, base, base)
+	require.NoError(t, err)
+	require.NoError(t, payments.Save(context.Background(), p))
+
+	_, err = svc.Anonymize(context.Background(), u.ID())
+	require.ErrorIs(t, err, duser.ErrOutstandingBalance)
+
+	require.NoError(t, p.Pay(base))
+	require.NoError(t, payments.Save(context.Background(), p))
+
+	anonymized, err := svc.Anonymize(context.Background(), u.ID())
+	require.NoError(t, err)
+	require.Equal(t, u.ID(), anonymized.ID())
+	require.Equal(t, duser.AnonymizedName, anonymized.Name())
+	require.Empty(t, anonymized.ExternalRef())
+	require.True(t, anonymized.Contact().IsZero())
+	require.Equal(t, duser.StatusClosed, anonymized.Status())
+
+	require.Empty(t, anonymized.PullEvents())
+
+	_, err = svc.FindByExternalRef(context.Background(), "merchant-42")
+	require.ErrorIs(t, err, duser.ErrUserNotFound)
+	_, err = svc.Anonymize(context.Background(), u.ID())
+	require.ErrorIs(t, err, duser.ErrUserAnonymized)
+}
+
+func TestAnonymize_ChecksPaymentsAndPublishesInUnitOfWork(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	repo := NewInMemoryUserRepo()
+	payments := upayment.NewInMemoryPaymentRepo()
+	var published []shared.DomainEvent
+	events := event.NewDispatcher(func(_ context.Context, evt shared.DomainEvent) error {
+		published = append(published, evt)
+		return nil
+	})
+	// The service's own payment store stays empty: only the unit's view counts.
+	svc := NewService(repo, upayment.NewInMemoryPaymentRepo(), dp.FixedClock{NowTime: base},
+		WithUnitOfWork(NewInMemoryUnitOfWork(repo, payments, events)))
+
+	u, err := svc.Register(ctx, "Kim", "")
+	require.NoError(t, err)
+	amount, err := money.FromMinor(10_000, money.CurrencyKRW)
+	require.NoError(t, err)
+	p, err := dp.New(u.ID(), amount, base, base)
+	require.NoError(t, err)
+	require.NoError(t, payments.Save(ctx, p))
+
+	_, err = svc.Anonymize(ctx, u.ID())
+	require.ErrorIs(t, err, duser.ErrOutstandingBalance)
+	require.Empty(t, published)
+
+	require.NoError(t, p.Pay(base))
+	require.NoError(t, payments.Save(ctx, p))
+	_, err = svc.Anonymize(ctx, u.ID())
+	require.NoError(t, err)
+	require.Len(t, published, 2)
+	require.Equal(t, duser.EventUserStatusChanged, published[0].EventType())
+	require.Equal(t, duser.UserAnonymized{UserID: u.ID().String(), OccurredAtTime: base}, published[1])
+}
@@ -0,0 +1,56 @@
+package user
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jaeyoung0509/compound-interest/domain/payment"
+	duser "github.com/jaeyoung0509/compound-interest/domain/user"
+	"github.com/jaeyoung0509/compound-interest/usecase/event"
+)
+
+// UnitOfWork runs fn with a user repository, the users' payments and an event
+// publisher that share one transaction, so a saved user and the events it
+// raised are committed together or not at all.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(users duser.Repository, payments payment.UserPayments, events event.Publisher) error) error
+}
+
+// checkpointer is implemented by in-memory repositories that can roll back,
+// such as InMemoryUserRepo.
+type checkpointer interface {
+	Checkpoint() (restore func())
+}
+
+// InMemoryUnitOfWork hands fn the given collaborators and, when fn fails,
+// restores the repositories implementing Checkpoint. Units run one at a time.
+// Events already handed to the publisher are not recalled.
+type InMemoryUnitOfWork struct {
+	mu       sync.Mutex
+	users    duser.Repository
+	payments payment.UserPayments
+	events   event.Publisher
+}
+
+func NewInMemoryUnitOfWork(users duser.Repository, payments payment.UserPayments, events event.Publisher) *InMemoryUnitOfWork {
+	return &InMemoryUnitOfWork{users: users, payments: payments, events: events}
+}
+
+func (u *InMemoryUnitOfWork) Do(ctx context.Context, fn func(users duser.Repository, payments payment.UserPayments, events event.Publisher) error) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	var restore func()
+	if c, ok := u.users.(checkpointer); ok {
+		restore = c.Checkpoint()
+	}
+	if err := fn(u.users, u.payments, u.events); err != nil {
+		if restore != nil {
+			restore()
+		}
+		return err
+	}
+	return nil
+}
+
+var _ UnitOfWork = (*InMemoryUnitOfWork)(nil)